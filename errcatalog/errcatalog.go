@@ -0,0 +1,201 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errcatalog reads error code catalogs, i.e. lists of error names
+// with their numbers, so that servers other than TiDB and MySQL can use
+// their own names in --error.
+//
+// Supported formats, chosen by file extension:
+//
+//   - .h: MySQL's mysqld_error.h (#define ER_X 1234) or mysqld_ername.h
+//     ({ "ER_X", 1234, "message", "HY000", ... }).
+//   - .csv: name,code[,sqlstate[,message]] records, # starts a comment and
+//     an optional name,code,... header line is skipped.
+//   - .yaml, .yml: a list of {name, code, sqlstate, message} maps.
+package errcatalog
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Entry is a single error of a catalog.
+type Entry struct {
+	Name     string `yaml:"name"`
+	Code     int    `yaml:"code"`
+	SQLState string `yaml:"sqlstate"`
+	Message  string `yaml:"message"`
+}
+
+// Conflict is reported when a name is already known with another code.
+type Conflict struct {
+	Name   string
+	Code   int
+	Known  int
+	Source string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("duplicate error name %s in %s (%d != %d)", c.Name, c.Source, c.Code, c.Known)
+}
+
+// Load reads the catalog at path, the format is chosen by its extension.
+func Load(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var entries []Entry
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".h":
+		entries, err = ParseHeader(f)
+	case ".csv":
+		entries, err = ParseCSV(f)
+	case ".yaml", ".yml":
+		entries, err = ParseYAML(f)
+	default:
+		return nil, fmt.Errorf("unknown error catalog format %q, expect .h, .csv, .yaml or .yml", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return entries, nil
+}
+
+var (
+	defineRegex = regexp.MustCompile(`^\s*#define\s+(\w+)\s+(\d+)\s*$`)
+	ernameRegex = regexp.MustCompile(`^\s*\{\s*"(\w+)"\s*,\s*(\d+)\s*,\s*"((?:[^"\\]|\\.)*)"(?:\s*,\s*"(\w*)")?`)
+)
+
+// ParseHeader reads MySQL's mysqld_error.h or mysqld_ername.h.
+func ParseHeader(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line := s.Text()
+		if m := ernameRegex.FindStringSubmatch(line); m != nil {
+			code, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, err
+			}
+			// C allows \' in string literals, Go does not.
+			msg, err := strconv.Unquote(`"` + strings.ReplaceAll(m[3], `\'`, `'`) + `"`)
+			if err != nil {
+				msg = m[3]
+			}
+			entries = append(entries, Entry{Name: m[1], Code: code, Message: msg, SQLState: m[4]})
+		} else if m := defineRegex.FindStringSubmatch(line); m != nil {
+			code, err := strconv.Atoi(m[2])
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, Entry{Name: m[1], Code: code})
+		}
+	}
+	return entries, s.Err()
+}
+
+// ParseCSV reads name,code[,sqlstate[,message]] records.
+func ParseCSV(r io.Reader) ([]Entry, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	var entries []Entry
+	for first := true; ; first = false {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("line %d: expect at least name,code", line)
+		}
+		code, err := strconv.Atoi(strings.TrimSpace(rec[1]))
+		if err != nil {
+			if first && strings.EqualFold(strings.TrimSpace(rec[1]), "code") {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: invalid code %q", line, rec[1])
+		}
+		e := Entry{Name: strings.TrimSpace(rec[0]), Code: code}
+		if len(rec) > 2 {
+			e.SQLState = strings.TrimSpace(rec[2])
+		}
+		if len(rec) > 3 {
+			e.Message = rec[3]
+		}
+		if err := e.validate(); err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		entries = append(entries, e)
+	}
+}
+
+// ParseYAML reads a list of {name, code, sqlstate, message} maps.
+func ParseYAML(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	if err := yaml.NewDecoder(r).Decode(&entries); err != nil && err != io.EOF {
+		return nil, err
+	}
+	for i, e := range entries {
+		if err := e.validate(); err != nil {
+			return nil, fmt.Errorf("entry %d: %v", i+1, err)
+		}
+	}
+	return entries, nil
+}
+
+var nameRegex = regexp.MustCompile(`^\w+$`)
+
+func (e Entry) validate() error {
+	if !nameRegex.MatchString(e.Name) {
+		return fmt.Errorf("invalid error name %q", e.Name)
+	}
+	if e.Code <= 0 {
+		return fmt.Errorf("invalid code %d for %s", e.Code, e.Name)
+	}
+	if e.SQLState != "" && len(e.SQLState) != 5 {
+		return fmt.Errorf("invalid SQLSTATE %q for %s", e.SQLState, e.Name)
+	}
+	return nil
+}
+
+// Merge adds entries to nameToNum. Names that are already known keep their
+// code, the ones known with another code are returned as conflicts.
+func Merge(nameToNum map[string]int, entries []Entry, source string) []Conflict {
+	var conflicts []Conflict
+	for _, e := range entries {
+		if v, ok := nameToNum[e.Name]; ok {
+			if v != e.Code {
+				conflicts = append(conflicts, Conflict{Name: e.Name, Code: e.Code, Known: v, Source: source})
+			}
+			continue
+		}
+		nameToNum[e.Name] = e.Code
+	}
+	return conflicts
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errcatalog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHeader(t *testing.T) {
+	input := `#ifndef MYSQLD_ERROR_INCLUDED
+#define ER_HASHCHK 1000
+#define ER_NISAMCHK 1001
+#define ER_ERROR_LAST 1001
+{ "ER_NO_SUCH_TABLE", 1146, "Table \'%-.192s.%-.192s\' doesn\'t exist", "42S02", "42S02", 0 },
+{ "ER_YES", 1003, "YES" },
+`
+	entries, err := ParseHeader(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{Name: "ER_HASHCHK", Code: 1000},
+		{Name: "ER_NISAMCHK", Code: 1001},
+		{Name: "ER_ERROR_LAST", Code: 1001},
+		{Name: "ER_NO_SUCH_TABLE", Code: 1146, SQLState: "42S02", Message: "Table '%-.192s.%-.192s' doesn't exist"},
+		{Name: "ER_YES", Code: 1003, Message: "YES"},
+	}, entries)
+}
+
+func TestParseCSV(t *testing.T) {
+	input := `name,code,sqlstate,message
+# our engine
+ER_FOO_UNSUPPORTED, 8001, HY000, "foo is not supported, yet"
+ER_BAR,8002
+`
+	entries, err := ParseCSV(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, []Entry{
+		{Name: "ER_FOO_UNSUPPORTED", Code: 8001, SQLState: "HY000", Message: "foo is not supported, yet"},
+		{Name: "ER_BAR", Code: 8002},
+	}, entries)
+
+	for _, input := range []string{"ER_FOO", "ER_FOO,abc", "ER FOO,1", "ER_FOO,1,HY00"} {
+		_, err = ParseCSV(strings.NewReader(input))
+		require.Error(t, err, input)
+	}
+}
+
+func TestLoadAndMerge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "errors.yaml")
+	err := os.WriteFile(path, []byte(`
+- name: ER_FOO_UNSUPPORTED
+  code: 8001
+  sqlstate: HY000
+  message: foo is not supported
+- name: ER_NO_SUCH_TABLE
+  code: 8002
+- name: ER_PARSE_ERROR
+  code: 1064
+`), 0644)
+	require.NoError(t, err)
+	entries, err := Load(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+
+	nameToNum := map[string]int{"ER_NO_SUCH_TABLE": 1146, "ER_PARSE_ERROR": 1064}
+	conflicts := Merge(nameToNum, entries, path)
+	require.Equal(t, []Conflict{{Name: "ER_NO_SUCH_TABLE", Code: 8002, Known: 1146, Source: path}}, conflicts)
+	require.Equal(t, map[string]int{"ER_NO_SUCH_TABLE": 1146, "ER_PARSE_ERROR": 1064, "ER_FOO_UNSUPPORTED": 8001}, nameToNum)
+
+	_, err = Load(filepath.Join(t.TempDir(), "errors.txt"))
+	require.Error(t, err)
+}
@@ -15,8 +15,10 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"flag"
 	"fmt"
+	"go/format"
 	"log"
 	"os"
 	"os/exec"
@@ -24,6 +26,9 @@ import (
 	"regexp"
 	"sort"
 	"strconv"
+	"strings"
+
+	"github.com/pingcap/mysql-tester/errcatalog"
 )
 
 const (
@@ -41,18 +46,38 @@ const (
 // limitations under the License.
 
 // Generated by generate_perror/main.go
-package main
+package tester
 
 var MysqlErrNameToNum = map[string]int{
+`
+	infoHeader = `// MysqlErrNumToInfo is the reverse index of MysqlErrNameToNum, with the
+// preferred name, SQLSTATE and message template of every known code.
+var MysqlErrNumToInfo = map[int]ErrInfo{
 `
 )
 
 var (
 	tidbCodePath string
+	runPerror    bool
+	catalogs     catalogList
 )
 
+// catalogList collects the repeatable -catalog flag.
+type catalogList []string
+
+func (c *catalogList) String() string {
+	return strings.Join(*c, ",")
+}
+
+func (c *catalogList) Set(path string) error {
+	*c = append(*c, path)
+	return nil
+}
+
 func init() {
-	flag.StringVar(&tidbCodePath, "path", "../tidb", "Path to TiDB source code root directory.")
+	flag.StringVar(&tidbCodePath, "path", "../tidb", "Path to TiDB source code root directory, empty to skip TiDB.")
+	flag.BoolVar(&runPerror, "perror", true, "Extract error names from the perror program of a MySQL installation.")
+	flag.Var(&catalogs, "catalog", "Additional error catalog (mysqld_error.h, mysqld_ername.h, .csv or .yaml), may be repeated.")
 }
 
 func checkNewErr(errCode string, i int, nameToNum map[string]int) {
@@ -91,29 +116,110 @@ func scanErrCodeFile(fileName string, nameToNum map[string]int) {
 	}
 }
 
-type ErrorCodeAndName struct {
-	Code int
-	Name string
+// errInfo is what we know about an error code besides its names.
+type errInfo struct {
+	sqlState string
+	message  string
 }
 
-func main() {
-	NameToNum := make(map[string]int)
+// setErrInfo records the SQLSTATE and message of code, the first source
+// that knows one wins.
+func setErrInfo(code int, sqlState, message string, infos map[int]*errInfo) {
+	info, ok := infos[code]
+	if !ok {
+		info = &errInfo{}
+		infos[code] = info
+	}
+	if info.sqlState == "" {
+		info.sqlState = sqlState
+	}
+	if info.message == "" {
+		info.message = message
+	}
+}
 
-	// First extract the known error names => numbers from TiDB errno module
+// scanErrNameFile extracts the message templates from TiDB's errname.go,
+// e.g. `ErrNoSuchTable: mysql.Message("Table '%-.192s.%-.192s' doesn't exist", nil),`
+func scanErrNameFile(fileName string, nameToNum map[string]int, infos map[int]*errInfo) {
+	r := regexp.MustCompile(`^\s+(\w+):\s+(?:mysql\.)?Message\(("(?:[^"\\]|\\.)*"),`)
+	scanTiDBMap(fileName, r, nameToNum, func(code int, value string) {
+		msg, err := strconv.Unquote(value)
+		if err != nil {
+			log.Fatal(err)
+		}
+		setErrInfo(code, "", msg, infos)
+	})
+}
 
-	// Second extract the known error names => numbers from TiDB parser/mysql module
+// scanStateFile extracts the SQLSTATEs from TiDB's parser/mysql/state.go,
+// e.g. `ErrNoSuchTable: "42S02",`
+func scanStateFile(fileName string, nameToNum map[string]int, infos map[int]*errInfo) {
+	r := regexp.MustCompile(`^\s+(\w+):\s+"(\w{5})",`)
+	scanTiDBMap(fileName, r, nameToNum, func(code int, value string) {
+		setErrInfo(code, value, "", infos)
+	})
+}
 
-	// Last use the perror program to extract error names from 1..20000 from MySQL
+// scanTiDBMap calls fn for every line of fileName matching r, whose first
+// group is a known error name.
+func scanTiDBMap(fileName string, r *regexp.Regexp, nameToNum map[string]int, fn func(code int, value string)) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		m := r.FindStringSubmatch(s.Text())
+		if len(m) != 3 {
+			continue
+		}
+		if code, ok := nameToNum[m[1]]; ok {
+			fn(code, m[2])
+		}
+	}
+	if s.Err() != nil {
+		log.Fatal(s.Err())
+	}
+}
 
-	flag.Parse()
+// preferredName picks the canonical name of an error code, which is used
+// when reporting errors: ER_* over Err* over OBSOLETE_* and others, then
+// alphabetically. Range markers like ErrErrorFirst come last.
+func preferredName(names []string) string {
+	rank := func(name string) int {
+		switch {
+		case strings.HasSuffix(name, "_FIRST") || strings.HasSuffix(name, "_LAST") ||
+			strings.HasSuffix(name, "First") || strings.HasSuffix(name, "Last"):
+			return 4
+		case strings.HasPrefix(name, "ER_"):
+			return 0
+		case strings.HasPrefix(name, "Err"):
+			return 1
+		case strings.HasPrefix(name, "OBSOLETE_"):
+			return 2
+		default:
+			return 3
+		}
+	}
+	best := names[0]
+	for _, name := range names[1:] {
+		if r, br := rank(name), rank(best); r < br || r == br && name < best {
+			best = name
+		}
+	}
+	return best
+}
 
-	scanErrCodeFile(filepath.Join(tidbCodePath, "/pkg/errno/errcode.go"), NameToNum)
-	errnoCodes := len(NameToNum)
-	log.Printf("Got %d error codes from errno/errcode.go!", errnoCodes)
-	scanErrCodeFile(tidbCodePath+"/pkg/parser/mysql/errcode.go", NameToNum)
-	parserCodes := len(NameToNum) - errnoCodes
-	log.Printf("Got %d New error codes from parser/mysql/errcode.go!", parserCodes)
+type ErrorCodeAndName struct {
+	Code int
+	Name string
+}
 
+// scanPerror extracts the error names and messages of a MySQL installation
+// by running its perror program for every code. perror prints no SQLSTATE,
+// so codes not known from another source get MySQL's default HY000.
+func scanPerror(nameToNum map[string]int, infos map[int]*errInfo) {
 	// similar to:
 	//seq 1 100000 | xargs perror 2> /dev/null | grep '^MySQL error code MY-[0-9]* ([A-Z_]*).*' | sed 's/^MySQL error code MY-0*\([[:digit:]]*\) (\([^)]*\)).*/"\2": \1,/'
 	maxError := 20000
@@ -131,10 +237,10 @@ func main() {
 			log.Fatal(err)
 		}
 		s := bufio.NewScanner(stdout)
-		r := regexp.MustCompile(`^MySQL error code MY-0*(\d+) \((\w+)\)`)
+		r := regexp.MustCompile(`^MySQL error code MY-0*(\d+) \((\w+)\):\s*(.*)$`)
 		for s.Scan() {
 			m := r.FindStringSubmatch(s.Text())
-			if len(m) == 3 && m[1] != "" && m[2] != "" {
+			if len(m) == 4 && m[1] != "" && m[2] != "" {
 				c, err := strconv.Atoi(m[1])
 				if err != nil {
 					log.Fatal(err)
@@ -142,7 +248,8 @@ func main() {
 				if c != i {
 					log.Fatalf("perror gave error with wrong number? (Want: %d Got: %d)", i, c)
 				}
-				checkNewErr(m[2], i, NameToNum)
+				checkNewErr(m[2], i, nameToNum)
+				setErrInfo(i, "HY000", m[3], infos)
 			}
 		}
 		err = cmd.Wait()
@@ -153,22 +260,68 @@ func main() {
 	if maxError >= 1000 {
 		fmt.Printf("\r")
 	}
-	log.Printf("Got %d New error codes from perror!", len(NameToNum)-parserCodes-errnoCodes)
-	f, err := os.Create("perror.go")
-	if err != nil {
-		log.Fatal(err)
+}
+
+func main() {
+	NameToNum := make(map[string]int)
+	infos := make(map[int]*errInfo)
+
+	// First extract the known error names => numbers from TiDB errno module
+
+	// Second extract the known error names => numbers from TiDB parser/mysql module
+
+	// Then use the perror program to extract error names from 1..20000 from MySQL
+
+	// Last add the names from the additional catalogs, e.g. of other servers
+
+	flag.Parse()
+
+	if tidbCodePath != "" {
+		scanErrCodeFile(filepath.Join(tidbCodePath, "/pkg/errno/errcode.go"), NameToNum)
+		errnoCodes := len(NameToNum)
+		log.Printf("Got %d error codes from errno/errcode.go!", errnoCodes)
+		scanErrCodeFile(tidbCodePath+"/pkg/parser/mysql/errcode.go", NameToNum)
+		log.Printf("Got %d New error codes from parser/mysql/errcode.go!", len(NameToNum)-errnoCodes)
+		scanErrNameFile(filepath.Join(tidbCodePath, "/pkg/errno/errname.go"), NameToNum, infos)
+		scanErrNameFile(filepath.Join(tidbCodePath, "/pkg/parser/mysql/errname.go"), NameToNum, infos)
+		scanStateFile(filepath.Join(tidbCodePath, "/pkg/parser/mysql/state.go"), NameToNum, infos)
+		// TiDB reports HY000 for all codes missing in MySQLState.
+		for _, code := range NameToNum {
+			setErrInfo(code, "HY000", "", infos)
+		}
+	}
+
+	if runPerror {
+		known := len(NameToNum)
+		scanPerror(NameToNum, infos)
+		log.Printf("Got %d New error codes from perror!", len(NameToNum)-known)
 	}
-	defer f.Close()
-	w := bufio.NewWriter(f)
-	_, err = w.WriteString(fileHeader)
+
+	for _, path := range catalogs {
+		entries, err := errcatalog.Load(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		known := len(NameToNum)
+		for _, e := range entries {
+			checkNewErr(e.Name, e.Code, NameToNum)
+			setErrInfo(e.Code, e.SQLState, e.Message, infos)
+		}
+		log.Printf("Got %d New error codes from %s!", len(NameToNum)-known, path)
+	}
+
+	var w bytes.Buffer
+	_, err := w.WriteString(fileHeader)
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
 	codes := make([]ErrorCodeAndName, 0, len(NameToNum))
+	codeToNames := make(map[int][]string)
 	for k, v := range NameToNum {
 		codes = append(codes, ErrorCodeAndName{Code: v, Name: k})
+		codeToNames[v] = append(codeToNames[v], k)
 	}
 	sort.Slice(codes, func(i, j int) bool {
 		return codes[i].Code < codes[j].Code || codes[i].Code == codes[j].Code && codes[i].Name < codes[j].Name
@@ -179,9 +332,41 @@ func main() {
 			log.Fatal(err)
 		}
 	}
+	_, err = w.WriteString("}\n\n" + infoHeader)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The reverse index, one entry per code with its preferred name.
+	for i := range codes {
+		if i > 0 && codes[i].Code == codes[i-1].Code {
+			continue
+		}
+		code := codes[i].Code
+		line := "\t" + strconv.Itoa(code) + ": {Name: " + strconv.Quote(preferredName(codeToNames[code]))
+		if info, ok := infos[code]; ok {
+			if info.sqlState != "" {
+				line += ", SQLState: " + strconv.Quote(info.sqlState)
+			}
+			if info.message != "" {
+				line += ", Message: " + strconv.Quote(info.message)
+			}
+		}
+		_, err = w.WriteString(line + "},\n")
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
 	_, err = w.WriteString("}\n")
 	if err != nil {
 		log.Fatal(err)
 	}
-	w.Flush()
+	// gofmt aligns the entries of the maps
+	src, err := format.Source(w.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("perror.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
 }
@@ -15,1253 +15,302 @@ package main
 
 import (
 	"bytes"
-	"context"
-	"database/sql"
 	"flag"
 	"fmt"
+	"io"
 	"os"
-	"path/filepath"
-	"regexp"
-	"sort"
-	"strconv"
+	"os/signal"
 	"strings"
-	"sync"
-	"time"
+	"syscall"
 
-	"github.com/defined2014/mysql"
-	"github.com/pingcap/errors"
+	"github.com/pingcap/mysql-tester/tester"
 	log "github.com/sirupsen/logrus"
 )
 
+// interruptedExitCode is the exit code of a run stopped by a signal.
+const interruptedExitCode = 130
+
 var (
-	host             string
-	port             string
-	user             string
-	passwd           string
-	logLevel         string
-	record           bool
-	params           string
-	all              bool
-	reserveSchema    bool
-	xmlPath          string
-	retryConnCount   int
-	collationDisable bool
-	checkErr         bool
-	extension        string
+	opts       = tester.DefaultOptions()
+	logLevel   string
+	all        bool
+	colorMode  string
+	configFile string
+	target     string
+	reference  string
+
+	matrix         []string
+	matrixTargets  []tester.MatrixTarget
+	matrixMarkdown string
+	matrixJSON     string
 )
 
 func init() {
-	flag.StringVar(&host, "host", "127.0.0.1", "The host of the TiDB/MySQL server.")
-	flag.StringVar(&port, "port", "3306", "The listen port of TiDB/MySQL server.")
-	flag.StringVar(&user, "user", "root", "The user for connecting to the database.")
-	flag.StringVar(&passwd, "passwd", "", "The password for the user.")
+	flag.StringVar(&opts.Host, "host", opts.Host, "The host of the TiDB/MySQL server.")
+	flag.StringVar(&opts.Port, "port", opts.Port, "The listen port of TiDB/MySQL server.")
+	flag.StringVar(&opts.User, "user", opts.User, "The user for connecting to the database.")
+	flag.StringVar(&opts.Password, "passwd", "", "The password for the user.")
 	flag.StringVar(&logLevel, "log-level", "error", "The log level of mysql-tester: info, warn, error, debug.")
-	flag.BoolVar(&record, "record", false, "Whether to record the test output to the result file.")
-	flag.StringVar(&params, "params", "", "Additional params pass as DSN(e.g. session variable)")
+	flag.Var(recordFlag{}, "record", "Whether to record the test output to the result file: true, changed (only rewrite the files which changed) or dry-run (print the diffs instead).")
+	flag.StringVar(&opts.Params, "params", "", "Additional params pass as DSN(e.g. session variable)")
 	flag.BoolVar(&all, "all", false, "run all tests")
-	flag.BoolVar(&reserveSchema, "reserve-schema", false, "Reserve schema after each test")
-	flag.StringVar(&xmlPath, "xunitfile", "", "The xml file path to record testing results.")
-	flag.IntVar(&retryConnCount, "retry-connection-count", 120, "The max number to retry to connect to the database.")
-	flag.BoolVar(&checkErr, "check-error", false, "if --error ERR does not match, return error instead of just warn")
-	flag.BoolVar(&collationDisable, "collation-disable", false, "run collation related-test with new-collation disabled")
-	flag.StringVar(&extension, "extension", "result", "the result file extension for result file")
-}
-
-const (
-	default_connection = "default"
-)
-
-type query struct {
-	firstWord string
-	Query     string
-	delimiter string
-	Line      int
-	tp        int
-}
-
-type Conn struct {
-	// DB might be a shared one by multiple Conn, if the connection information are the same.
-	mdb *sql.DB
-	// connection information.
-	hostName string
-	userName string
-	password string
-	db       string
-
-	conn *sql.Conn
-}
-
-type ReplaceColumn struct {
-	col     int
-	replace []byte
-}
-
-type ReplaceRegex struct {
-	regex   *regexp.Regexp
-	replace string
-}
-
-type tester struct {
-	mdb  *sql.DB
-	name string
-
-	originalSchemas map[string]struct{}
-
-	// 连接管理器，负责管理所有数据库连接
-	connManager *ConnectionManager
-
-	// 保留curr字段以便兼容旧代码
-	curr *Conn
-
-	buf bytes.Buffer
-
-	// enable query log will output origin statement into result file too
-	// use --disable_query_log or --enable_query_log to control it
-	enableQueryLog bool
-
-	// enable result log will output to result file or not.
-	// use --enable_result_log or --disable_result_log to control it
-	enableResultLog bool
-
-	// sortedResult make the output or the current query sorted.
-	sortedResult bool
-
-	enableConcurrent bool
-
-	// Disable or enable warnings. This setting is enabled by default.
-	// With this setting enabled, mysqltest uses SHOW WARNINGS to display
-	// any warnings produced by SQL statements.
-	enableWarning bool
-
-	// enable query info, like rowsAffected, lastMessage etc.
-	enableInfo bool
-
-	// check expected error, use --error before the statement
-	// see http://dev.mysql.com/doc/mysqltest/2.0/en/writing-tests-expecting-errors.html
-	expectedErrs []string
-
-	// only for test, not record, every time we execute a statement, we should read the result
-	// data to check correction.
-	resultFD *os.File
-
-	// conns record connection created by test.
-	conn map[string]*Conn
-
-	// currConnName record current connection name.
-	currConnName string
-
-	// replace output column through --replace_column 1 <static data> 3 #
-	replaceColumn []ReplaceColumn
-
-	// replace output result through --replace_regex /\.dll/.so/
-	replaceRegex []*ReplaceRegex
-
-	// the delimter for TiDB, default value is ";"
-	delimiter string
-}
-
-func newTester(name string) *tester {
-	t := new(tester)
-
-	t.name = name
-	t.enableQueryLog = true
-	t.enableResultLog = true
-	// disable warning by default since our a lot of test cases
-	// are ported wihtout explictly "disablewarning"
-	t.enableWarning = false
-	t.enableConcurrent = false
-	t.enableInfo = false
-	t.delimiter = ";"
-	// 初始化连接映射
-	t.conn = make(map[string]*Conn)
-	// 初始化连接管理器
-	t.connManager = NewConnectionManager(port, params, retryConnCount)
-
-	return t
-}
-
-func setSessionVariable(db *Conn) {
-	ctx := context.Background()
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_init_chunk_size=1"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_init_chunk_size=1\" err[%v]", err)
-	}
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_max_chunk_size=32"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_max_chunk_size=32\" err[%v]", err)
-	}
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_multi_statement_mode=1"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_multi_statement_mode=1\" err[%v]", err)
-	}
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_hash_join_concurrency=1"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_hash_join_concurrency=1\" err[%v]", err)
-	}
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_enable_pseudo_for_outdated_stats=false"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_enable_pseudo_for_outdated_stats=false\" err[%v]", err)
-	}
-	// enable tidb_enable_analyze_snapshot in order to let analyze request with SI isolation level to get accurate response
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_enable_analyze_snapshot=1"); err != nil {
-		log.Warnf("Executing \"SET @@tidb_enable_analyze_snapshot=1 failed\" err[%v]", err)
-	} else {
-		log.Debugf("enable tidb_enable_analyze_snapshot")
-	}
-	if _, err := db.conn.ExecContext(ctx, "SET @@tidb_enable_clustered_index='int_only'"); err != nil {
-		log.Fatalf("Executing \"SET @@tidb_enable_clustered_index='int_only'\" err[%v]", err)
-	}
-}
-
-// isTiDB returns true if the DB is confirmed to be TiDB
-func isTiDB(db *sql.DB) bool {
-	if _, err := db.Exec("SELECT tidb_version()"); err != nil {
-		log.Infof("This doesn't look like a TiDB server, err[%v]", err)
-		return false
-	}
-	return true
-}
-
-func (t *tester) addConnection(connName, hostName, userName, password, db string) {
-	// 使用连接管理器添加连接
-	conn, err := t.connManager.AddConnection(connName, hostName, userName, password, db, t.expectedErrs)
+	flag.BoolVar(&opts.ReserveSchema, "reserve-schema", false, "Reserve schema after each test")
+	flag.StringVar(&opts.XUnitFile, "xunitfile", "", "The xml file path to record testing results.")
+	flag.IntVar(&opts.RetryConnCount, "retry-connection-count", opts.RetryConnCount, "The max number to retry to connect to the database.")
+	flag.BoolVar(&opts.CheckErr, "check-error", false, "if --error ERR does not match, return error instead of just warn")
+	flag.BoolVar(&opts.CollationDisable, "collation-disable", false, "run collation related-test with new-collation disabled")
+	flag.StringVar(&opts.Extension, "extension", opts.Extension, "the result file extension for result file")
+	flag.IntVar(&opts.Parallel, "parallel", opts.Parallel, "the number of tests to run at the same time, each in its own schema")
+	flag.DurationVar(&opts.StmtTimeout, "stmt-timeout", 0, "kill a statement running longer and fail the test, 0 means no limit")
+	flag.DurationVar(&opts.TestTimeout, "test-timeout", 0, "fail a test running longer, unless it declares its own @timeout, 0 means no limit")
+	flag.Var(listFlag{&opts.IncludeTags}, "tags", "comma separated tags, only run the tests having one of them")
+	flag.Var(listFlag{&opts.ExcludeTags}, "exclude-tags", "comma separated tags, skip the tests having one of them")
+	flag.StringVar(&opts.Run, "run", "", "only run the tests whose name matches this regular expression")
+	flag.StringVar(&opts.Exclude, "exclude", "", "skip the tests whose name matches this regular expression")
+	flag.StringVar(&opts.SkipFile, "skip-file", "", "YAML file listing the tests to report as skipped, with a reason and an optional until date")
+	flag.StringVar(&opts.Shard, "shard", "", "only run the i-th of n parts of the selected tests, as i/n, e.g. 2/4")
+	flag.StringVar(&opts.ShardDurations, "shard-durations", "", "XUnit report of a previous run, used by -shard to balance the parts by duration")
+	flag.StringVar(&opts.FailedFile, "failed-file", opts.FailedFile, "the file recording the tests which failed in the last run")
+	flag.BoolVar(&opts.RerunFailed, "rerun-failed", false, "only run the tests which failed in the last run, as recorded in -failed-file")
+	flag.IntVar(&opts.Retries, "retries", 0, "retry a failed test up to this many times in a fresh schema, a test passing after a retry is reported as flaky")
+	flag.BoolVar(&opts.AllMismatches, "all-mismatches", false, "keep running a test after a result mismatch and report all the mismatching statements at its end")
+	flag.IntVar(&opts.DiffContext, "diff-context", opts.DiffContext, "the number of unchanged lines shown around the changes of a result diff")
+	flag.StringVar(&colorMode, "color", "auto", "color the result diffs on the console: auto (when stdout is a terminal), always or never")
+	flag.Var(listFlag{&opts.ErrorCatalogs}, "error-catalog", "comma separated error catalog files (.h, .csv, .yaml) adding error names for --error")
+	flag.StringVar(&configFile, "config", "", "YAML configuration file describing the targets and the defaults of the runs, the flags given override it")
+	flag.StringVar(&target, "target", "", "the target of -config to run against, its default_target if not set")
+	flag.StringVar(&reference, "reference", "", "the target of -config whose output is the expected one, compared statement by statement instead of the result files")
+	flag.Var(listFlag{&matrix}, "matrix", "comma separated targets of -config to run the tests against and compare with the reference, -reference or the target run against, in a compatibility matrix")
+	flag.StringVar(&matrixMarkdown, "matrix-markdown", "", "the file to write the compatibility matrix to as Markdown, stdout if neither it nor -matrix-json is set")
+	flag.StringVar(&matrixJSON, "matrix-json", "", "the file to write the compatibility matrix to as JSON")
+	flag.StringVar(&opts.Dialect, "dialect", "", "the dialect of the server: mysql, tidb, mariadb, generic or the name of another engine, detected if not set")
+	flag.Var(initSQLFlag{}, "init-sql", "semicolon separated statements run on every new connection, instead of the init_sql of the target or the default ones of TiDB, empty to run none")
+	flag.Var(listFlag{&opts.Capabilities}, "capabilities", "comma separated capabilities of the server, instead of the ones of its dialect: ps_protocol, session_track, reset_connection, gtid")
+}
+
+// recordFlag is the -record flag. It is a boolean flag, -record and
+// -record=1 keep recording every result file, which also takes the values
+// changed and dry-run.
+type recordFlag struct{}
+
+func (recordFlag) IsBoolFlag() bool { return true }
+
+func (recordFlag) String() string {
+	switch opts.RecordMode {
+	case tester.RecordAll:
+		return "true"
+	case tester.RecordNone:
+		return "false"
+	}
+	return string(opts.RecordMode)
+}
+
+func (recordFlag) Set(s string) error {
+	mode, err := tester.ParseRecordMode(s)
 	if err != nil {
-		if t.expectedErrs == nil {
-			log.Fatalf("Open db err %v", err)
-		}
-		t.expectedErrs = nil
-		return
+		return err
 	}
-	
-	// 为了兼容旧代码，仍然更新t.conn和t.curr
-	t.conn[connName] = conn
-	t.curr = conn
-	t.currConnName = connName
+	opts.RecordMode = mode
+	return nil
 }
 
-func (t *tester) switchConnection(connName string) {
-	// 使用连接管理器切换连接
-	conn, err := t.connManager.SwitchConnection(connName)
-	if err != nil {
-		log.Fatalf("Connection %v doesn't exist: %v", connName, err)
-	}
-	
-	// 为了兼容旧代码，仍然更新t.mdb和t.curr
-	t.mdb = conn.mdb
-	t.curr = conn
-	t.currConnName = connName
-	
-	// 同时更新旧的连接映射，保持一致性
-	t.conn[connName] = conn
+// listFlag is a comma separated list flag.
+type listFlag struct {
+	list *[]string
 }
 
-func (t *tester) disconnect(connName string) {
-	// 使用连接管理器断开连接
-	err := t.connManager.DisconnectConnection(connName)
-	if err != nil {
-		log.Fatalf("断开连接 %v 失败: %v", connName, err)
+func (f listFlag) String() string {
+	if f.list == nil {
+		return ""
 	}
-	
-	// 从旧的连接映射中删除
-	delete(t.conn, connName)
-	
-	// 如果存在默认连接，则切换到默认连接
-	if _, ok := t.conn[default_connection]; ok {
-		// 切换到默认连接
-		t.switchConnection(default_connection)
-	} else {
-		// 如果没有默认连接，则清空当前连接
-		t.curr = nil
-		t.mdb = nil
-		t.currConnName = ""
-	}
-}
-
-func (t *tester) preProcess() {
-	// 初始化连接映射
-	t.conn = make(map[string]*Conn)
-	
-	// 使用test数据库建立初始连接
-	dbName := "test"
-	conn, err := t.connManager.AddConnection(default_connection, host, user, passwd, dbName, nil)
-	if err != nil {
-		log.Fatalf("Open db err %v", err)
-	}
-	
-	// 获取数据库连接用于执行后续操作
-	mdb := conn.mdb
-
-	if !reserveSchema {
-		// 存储原始数据库架构
-		t.originalSchemas = make(map[string]struct{})
-		rows, err := mdb.Query("show databases")
-		if err != nil {
-			log.Errorf("failed to get databases: %s", err.Error())
-			return
-		}
-		for rows.Next() {
-			rows.Scan(&dbName)
-			t.originalSchemas[dbName] = struct{}{}
-		}
-	}
-
-	// 创建测试专用数据库
-	dbName = strings.ReplaceAll(t.name, "/", "__")
-	log.Debugf("Create new db `%s`", dbName)
-	if _, err = mdb.Exec(fmt.Sprintf("create database `%s`", dbName)); err != nil {
-		log.Fatalf("Executing create db %s err[%v]", dbName, err)
-	}
-	
-	// 断开旧连接
-	t.connManager.DisconnectConnection(default_connection)
-	delete(t.conn, default_connection)
-	
-	// 创建新连接到测试数据库
-	conn, err = t.connManager.AddConnection(default_connection, host, user, passwd, dbName, nil)
-	if err != nil {
-		log.Fatalf("Open db err %v", err)
-	}
-	
-	// 更新tester状态
-	t.conn[default_connection] = conn
-	t.curr = conn
-	t.mdb = conn.mdb
-	t.currConnName = default_connection
+	return strings.Join(*f.list, ",")
 }
 
-func (t *tester) postProcess() {
-	// 使用延迟函数确保所有连接在函数结束时关闭
-	defer func() {
-		// 使用连接管理器关闭所有连接
-		t.connManager.CloseAllConnections()
-		
-		// 为了兼容旧代码，也关闭t.conn中的连接
-		for _, v := range t.conn {
-			if v.conn != nil {
-				v.conn.Close()
-			}
-		}
-		
-		// 如果t.mdb存在，也关闭它
-		if t.mdb != nil {
-			t.mdb.Close()
-		}
-	}()
-	
-	// 如果不保留数据库架构，则删除测试过程中创建的数据库
-	if !reserveSchema {
-		// 确保有活动连接
-		if t.curr == nil || t.curr.mdb == nil {
-			log.Error("无法清理数据库：当前连接为空")
-			return
-		}
-		
-		// 查询所有数据库
-		rows, err := t.curr.mdb.Query("show databases")
-		if err != nil {
-			log.Errorf("failed to get databases: %s", err.Error())
-			return
-		}
-		
-		// 删除测试过程中创建的数据库
-		var dbName string
-		for rows.Next() {
-			rows.Scan(&dbName)
-			if _, exists := t.originalSchemas[dbName]; !exists {
-				_, err := t.curr.mdb.Exec(fmt.Sprintf("drop database `%s`", dbName))
-				if err != nil {
-					log.Errorf("failed to drop database: %s", err.Error())
-					return
-				}
-			}
-		}
-	}
+func (f listFlag) Set(s string) error {
+	*f.list = tester.SplitList(s)
+	return nil
 }
 
-func (t *tester) addFailure(testSuite *XUnitTestSuite, err *error, cnt int) {
-	testSuite.TestCases = append(testSuite.TestCases, XUnitTestCase{
-		Classname:  "",
-		Name:       t.testFileName(),
-		Time:       "",
-		QueryCount: cnt,
-		Failure:    (*err).Error(),
-	})
-	testSuite.Failures++
-}
+// initSQLFlag is the -init-sql flag, statements separated by semicolons. An
+// empty value sets no statement, which disables the default ones of TiDB.
+type initSQLFlag struct{}
 
-func (t *tester) addSuccess(testSuite *XUnitTestSuite, startTime *time.Time, cnt int) {
-	testSuite.TestCases = append(testSuite.TestCases, XUnitTestCase{
-		Classname:  "",
-		Name:       t.testFileName(),
-		Time:       fmt.Sprintf("%fs", time.Since(*startTime).Seconds()),
-		QueryCount: cnt,
-	})
+func (initSQLFlag) String() string {
+	return strings.Join(opts.InitSQL, "; ")
 }
 
-func (t *tester) Run() error {
-	t.preProcess()
-	defer t.postProcess()
-	queries, err := t.loadQueries()
-	if err != nil {
-		err = errors.Trace(err)
-		t.addFailure(&testSuite, &err, 0)
-		return err
-	}
-
-	if err = t.openResult(); err != nil {
-		err = errors.Trace(err)
-		t.addFailure(&testSuite, &err, 0)
-		return err
-	}
-
-	var s string
-	defer func() {
-		if t.resultFD != nil {
-			t.resultFD.Close()
+func (initSQLFlag) Set(s string) error {
+	opts.InitSQL = []string{}
+	for _, stmt := range strings.Split(s, ";") {
+		if stmt = strings.TrimSpace(stmt); stmt != "" {
+			opts.InitSQL = append(opts.InitSQL, stmt)
 		}
-	}()
-
-	testCnt := 0
-	startTime := time.Now()
-	var concurrentQueue []query
-	var concurrentSize int
-	for _, q := range queries {
-		s = q.Query
-		switch q.tp {
-		case Q_ENABLE_QUERY_LOG:
-			t.enableQueryLog = true
-		case Q_DISABLE_QUERY_LOG:
-			t.enableQueryLog = false
-		case Q_ENABLE_RESULT_LOG:
-			t.enableResultLog = true
-		case Q_DISABLE_RESULT_LOG:
-			t.enableResultLog = false
-		case Q_DISABLE_WARNINGS:
-			t.enableWarning = false
-		case Q_ENABLE_WARNINGS:
-			t.enableWarning = true
-		case Q_ENABLE_INFO:
-			t.enableInfo = true
-		case Q_DISABLE_INFO:
-			t.enableInfo = false
-		case Q_BEGIN_CONCURRENT:
-			// mysql-tester enhancement
-			concurrentQueue = make([]query, 0)
-			t.enableConcurrent = true
-			if s == "" {
-				concurrentSize = 8
-			} else {
-				concurrentSize, err = strconv.Atoi(strings.TrimSpace(s))
-				if err != nil {
-					err = errors.Annotate(err, "Atoi failed")
-					t.addFailure(&testSuite, &err, testCnt)
-					return err
-				}
-			}
-		case Q_END_CONCURRENT:
-			t.enableConcurrent = false
-			if err = t.concurrentRun(concurrentQueue, concurrentSize); err != nil {
-				err = errors.Annotate(err, fmt.Sprintf("concurrent test failed in %v", t.name))
-				t.addFailure(&testSuite, &err, testCnt)
-				return err
-			}
-			t.expectedErrs = nil
-		case Q_ERROR:
-			t.expectedErrs = strings.Split(strings.TrimSpace(s), ",")
-		case Q_ECHO:
-			varSearch := regexp.MustCompile(`\$([A-Za-z0-9_]+)( |$)`)
-			s := varSearch.ReplaceAllStringFunc(s, func(s string) string {
-				return os.Getenv(varSearch.FindStringSubmatch(s)[1])
-			})
-
-			t.buf.WriteString(s)
-			t.buf.WriteString("\n")
-		case Q_QUERY:
-			if t.enableConcurrent {
-				concurrentQueue = append(concurrentQueue, q)
-			} else if err = t.execute(q); err != nil {
-				err = errors.Annotate(err, fmt.Sprintf("sql:%v", q.Query))
-				t.addFailure(&testSuite, &err, testCnt)
-				return err
-			}
-
-			testCnt++
-
-			t.sortedResult = false
-			t.replaceColumn = nil
-			t.replaceRegex = nil
-		case Q_SORTED_RESULT:
-			t.sortedResult = true
-		case Q_REPLACE_COLUMN:
-			// TODO: Use CSV module or so to handle quoted replacements
-			t.replaceColumn = nil // Only use the latest one!
-			cols := strings.Fields(q.Query)
-			// Require that col + replacement comes in pairs otherwise skip the last column number
-			for i := 0; i < len(cols)-1; i = i + 2 {
-				colNr, err := strconv.Atoi(cols[i])
-				if err != nil {
-					err = errors.Annotate(err, fmt.Sprintf("Could not parse column in --replace_column: sql:%v", q.Query))
-					t.addFailure(&testSuite, &err, testCnt)
-					return err
-				}
-
-				t.replaceColumn = append(t.replaceColumn, ReplaceColumn{col: colNr, replace: []byte(cols[i+1])})
-			}
-		case Q_CONNECT:
-			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
-			q.Query = q.Query[1 : len(q.Query)-1]
-			args := strings.Split(q.Query, ",")
-			for i := range args {
-				args[i] = strings.TrimSpace(args[i])
-			}
-			for i := 0; i < 4; i++ {
-				args = append(args, "")
-			}
-			t.addConnection(args[0], args[1], args[2], args[3], args[4])
-		case Q_CONNECTION:
-			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
-			t.switchConnection(q.Query)
-		case Q_DISCONNECT:
-			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
-			t.disconnect(q.Query)
-		case Q_LET:
-			q.Query = strings.TrimSpace(q.Query)
-			eqIdx := strings.Index(q.Query, "=")
-			if eqIdx > 1 {
-				start := 0
-				if q.Query[0] == '$' {
-					start = 1
-				}
-				varName := strings.TrimSpace(q.Query[start:eqIdx])
-				varValue := strings.TrimSpace(q.Query[eqIdx+1:])
-				varSearch := regexp.MustCompile("`(.*)`")
-				varValue = varSearch.ReplaceAllStringFunc(varValue, func(s string) string {
-					s = strings.Trim(s, "`")
-					r, err := t.executeStmtString(s)
-					if err != nil {
-						log.WithFields(log.Fields{
-							"query": s, "line": q.Line},
-						).Error("failed to perform let query")
-						return ""
-					}
-					return r
-				})
-				os.Setenv(varName, varValue)
-			}
-		case Q_REMOVE_FILE:
-			err = os.Remove(strings.TrimSpace(q.Query))
-			if err != nil {
-				return errors.Annotate(err, "failed to remove file")
-			}
-		case Q_REPLACE_REGEX:
-			t.replaceRegex = nil
-			regex, err := ParseReplaceRegex(q.Query)
-			if err != nil {
-				return errors.Annotate(err, fmt.Sprintf("Could not parse regex in --replace_regex: line: %d sql:%v", q.Line, q.Query))
-			}
-			t.replaceRegex = regex
-		default:
-			log.WithFields(log.Fields{"command": q.firstWord, "arguments": q.Query, "line": q.Line}).Warn("command not implemented")
-		}
-	}
-
-	// check do we have remained lines in result file
-	buf := make([]byte, 32)
-	if n, _ := t.resultFD.ReadAt(buf, int64(t.buf.Len())); n != 0 {
-		return errors.Trace(errors.Errorf("There is extra data at the end of the result file: %s", buf))
-	}
-
-	fmt.Printf("%s: ok! %d test cases passed, take time %v s\n", t.testFileName(), testCnt, time.Since(startTime).Seconds())
-
-	if xmlPath != "" {
-		t.addSuccess(&testSuite, &startTime, testCnt)
-	}
-
-	return t.flushResult()
-}
-
-func (t *tester) concurrentRun(concurrentQueue []query, concurrentSize int) error {
-	if len(concurrentQueue) == 0 {
-		return nil
-	}
-	offset := t.buf.Len()
-
-	if concurrentSize <= 0 {
-		return errors.Errorf("concurrentSize must be positive")
-	}
-	if concurrentSize > len(concurrentQueue) {
-		concurrentSize = len(concurrentQueue)
-	}
-	batchQuery := make([][]query, concurrentSize)
-	for i, query := range concurrentQueue {
-		j := i % concurrentSize
-		batchQuery[j] = append(batchQuery[j], query)
 	}
-	errOccured := make(chan struct{}, len(concurrentQueue))
-	var wg sync.WaitGroup
-	wg.Add(len(batchQuery))
-	for _, q := range batchQuery {
-		go t.concurrentExecute(q, &wg, errOccured)
-	}
-	wg.Wait()
-	close(errOccured)
-	if _, ok := <-errOccured; ok {
-		return errors.Errorf("Run failed")
-	}
-	buf := t.buf.Bytes()[:offset]
-	t.buf = *(bytes.NewBuffer(buf))
 	return nil
 }
 
-func initConn(mdb *sql.DB, host, user, passwd, dbName string) (*Conn, error) {
-	mdb.SetMaxIdleConns(-1) // Disable the underlying connection pool.
-	sqlConn, err := mdb.Conn(context.Background())
-	if err != nil {
-		return nil, err
-	}
-	conn := &Conn{
-		mdb:      mdb,
-		hostName: host,
-		userName: user,
-		password: passwd,
-		db:       dbName,
-		conn:     sqlConn,
-	}
-	if isTiDB(mdb) {
-		setSessionVariable(conn)
-	}
-	if dbName != "" {
-		if _, err = sqlConn.ExecContext(context.Background(), fmt.Sprintf("use `%s`", dbName)); err != nil {
-			log.Fatalf("Executing Use test err[%v]", err)
-		}
-	}
-	return conn, nil
+// subcommands are run instead of tests when the first argument names one,
+// e.g. `mysql-tester perror 1146`. They return the exit code.
+var subcommands = map[string]func(args []string) int{
+	"perror": perrorCommand,
+	"accept": acceptCommand,
 }
 
-func (t *tester) concurrentExecute(querys []query, wg *sync.WaitGroup, errOccured chan struct{}) {
-	defer wg.Done()
-	// 创建新的tester实例用于并发执行
-	tt := newTester(t.name)
-	
-	// 使用连接管理器创建到测试数据库的连接
-	conn, err := tt.connManager.AddConnection(default_connection, host, user, passwd, t.name, nil)
-	if err != nil {
-		log.Fatalf("Open db err %v", err)
+// perrorCommand prints the name, SQLSTATE, message template and aliases of
+// the given error codes or names, like MySQL's perror.
+func perrorCommand(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "usage: mysql-tester perror <code|name>...")
+		return 2
 	}
-	
-	// 更新tester状态
-	tt.curr = conn
-	tt.mdb = conn.mdb
-	tt.conn[default_connection] = conn
-	
-	// 确保所有连接在函数结束时关闭
-	defer tt.connManager.CloseAllConnections()
-
-	for _, query := range querys {
-		if len(query.Query) == 0 {
-			return
-		}
-
-		err := tt.stmtExecute(query)
-		if err != nil && len(t.expectedErrs) > 0 {
-			for _, tStr := range t.expectedErrs {
-				if strings.Contains(err.Error(), tStr) {
-					err = nil
-					break
-				}
-			}
-		}
-		if err != nil {
-			msgs <- testTask{
-				test: t.name,
-				err:  errors.Trace(errors.Errorf("run \"%v\" at line %d err %v", query.Query, query.Line, err)),
-			}
-			errOccured <- struct{}{}
-			return
+	ret := 0
+	for _, arg := range args {
+		if err := tester.PrintErrorInfo(os.Stdout, arg); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			ret = 1
 		}
 	}
+	return ret
 }
 
-func (t *tester) loadQueries() ([]query, error) {
-	data, err := os.ReadFile(t.testFileName())
-	if err != nil {
-		return nil, err
-	}
-
-	seps := bytes.Split(data, []byte("\n"))
-	queries := make([]query, 0, len(seps))
-	buffer := ""
-	for i, v := range seps {
-		v := bytes.TrimSpace(v)
-		s := string(v)
-		// we will skip # comment here
-		if strings.HasPrefix(s, "#") {
-			if len(buffer) != 0 {
-				return nil, errors.Errorf("Has remained message(%s) before COMMENTS", buffer)
-			}
-			continue
-		} else if strings.HasPrefix(s, "--") {
-			if len(buffer) != 0 {
-				return nil, errors.Errorf("Has remained message(%s) before COMMANDS", buffer)
-			}
-			q, err := ParseQuery(query{Query: s, Line: i + 1, delimiter: t.delimiter})
-			if err != nil {
-				return nil, err
-			}
-			if q == nil {
-				continue
-			}
-			if q.tp == Q_DELIMITER {
-				tokens := strings.Split(strings.TrimSpace(q.Query), " ")
-				if len(tokens) == 0 {
-					return nil, errors.Errorf("DELIMITER must be followed by a 'delimiter' character or string")
-				}
-				t.delimiter = tokens[0]
-			} else {
-				queries = append(queries, *q)
-			}
-			continue
-		} else if strings.HasPrefix(strings.ToLower(strings.TrimSpace(s)), "delimiter ") {
-			if len(buffer) != 0 {
-				return nil, errors.Errorf("Has remained message(%s) before DELIMITER COMMAND", buffer)
-			}
-			tokens := strings.Split(strings.TrimSpace(s), " ")
-			if len(tokens) <= 1 {
-				return nil, errors.Errorf("DELIMITER must be followed by a 'delimiter' character or string")
-			}
-			t.delimiter = tokens[1]
-			continue
-		} else if len(s) == 0 {
-			continue
-		}
-
-		if len(buffer) != 0 {
-			buffer += "\n"
-		}
-		buffer += s
-		for {
-			idx := strings.LastIndex(buffer, t.delimiter)
-			if idx == -1 {
-				break
-			}
-
-			queryStr := buffer[:idx+len(t.delimiter)]
-			buffer = buffer[idx+len(t.delimiter):]
-			q, err := ParseQuery(query{Query: strings.TrimSpace(queryStr), Line: i + 1, delimiter: t.delimiter})
-			if err != nil {
-				return nil, err
-			}
-			if q == nil {
-				continue
-			}
-			queries = append(queries, *q)
-		}
-		// If has remained comments, ignore them.
-		if len(buffer) != 0 && strings.HasPrefix(strings.TrimSpace(buffer), "#") {
-			buffer = ""
-		}
-	}
-	if len(buffer) != 0 {
-		return nil, errors.Errorf("Has remained text(%s) in file", buffer)
-	}
-	return queries, nil
+// acceptCommand promotes the reject files of the given tests, or of every
+// test, to their result files.
+func acceptCommand(args []string) int {
+	return tester.AcceptRejects(os.Stdout, opts, args)
 }
 
-func (t *tester) stmtExecute(query query) (err error) {
-	if t.enableQueryLog {
-		t.buf.WriteString(query.Query)
-		t.buf.WriteString("\n")
-	}
-
-	return t.executeStmt(strings.TrimSuffix(query.Query, query.delimiter))
+// handleSignals interrupts the run on the first SIGINT or SIGTERM. A second
+// one writes the report as it is and exits at once.
+func handleSignals(r *tester.Runner) {
+	ch := make(chan os.Signal, 2)
+	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		sig := <-ch
+		log.Warnf("got %v, finishing the running statements, send it again to exit now", sig)
+		r.Interrupt()
+		<-ch
+		r.FlushReport()
+		os.Exit(interruptedExitCode)
+	}()
 }
 
-// checkExpectedError check if error was expected
-// If so, it will handle Buf and return nil
-func (t *tester) checkExpectedError(q query, err error) error {
-	if err == nil {
-		if len(t.expectedErrs) == 0 {
-			return nil
-		}
-		for _, s := range t.expectedErrs {
-			s = strings.TrimSpace(s)
-			if s == "0" {
-				// 0 means accept any error!
-				return nil
-			}
-		}
-		if !checkErr {
-			log.Warnf("%s:%d query succeeded, but expected error(s)! (expected errors: %s) (query: %s)",
-				t.name, q.Line, strings.Join(t.expectedErrs, ","), q.Query)
-			return nil
-		}
-		return errors.Errorf("Statement succeeded, expected error(s) '%s'", strings.Join(t.expectedErrs, ","))
-	}
-	// 如果有错误但没有期望的错误，则返回该错误
+// serverFlags describe the server the tests run against, they do not apply
+// to the -reference and -matrix targets.
+var serverFlags = map[string]bool{
+	"host":         true,
+	"port":         true,
+	"user":         true,
+	"passwd":       true,
+	"dialect":      true,
+	"capabilities": true,
+	"init-sql":     true,
+}
+
+// applyConfig sets opts from the target of the configuration file, then
+// from the flags given on the command line again, since they override it.
+// The -reference and -matrix targets get the given flags too, except the
+// server ones.
+func applyConfig() error {
+	cfg, err := tester.LoadConfig(configFile)
 	if err != nil {
-		// 此时t.expectedErrs肯定为空，因为前面的条件已经处理了有期望错误的情况
 		return err
 	}
-	// Parse the error to get the mysql error code
-	errNo := 0
-	switch innerErr := errors.Cause(err).(type) {
-	case *mysql.MySQLError:
-		errNo = int(innerErr.Number)
-	}
-	if errNo == 0 {
-		log.Warnf("%s:%d Could not parse mysql error: %s", t.name, q.Line, err.Error())
+	given := make(map[string]string)
+	flag.Visit(func(f *flag.Flag) {
+		given[f.Name] = f.Value.String()
+	})
+	base := opts
+	if opts, err = targetOptions(cfg, base, target, given, true); err != nil {
 		return err
 	}
-	for _, s := range t.expectedErrs {
-		s = strings.TrimSpace(s)
-		checkErrNo, err1 := strconv.Atoi(s)
-		if err1 != nil {
-			i, ok := MysqlErrNameToNum[s]
-			if ok {
-				checkErrNo = i
-			} else {
-				if len(t.expectedErrs) > 1 {
-					log.Warnf("%s:%d Unknown named error %s in --error %s", t.name, q.Line, s, strings.Join(t.expectedErrs, ","))
-				} else {
-					log.Warnf("%s:%d Unknown named --error %s", t.name, q.Line, s)
-				}
-				continue
-			}
-		}
-		if errNo == checkErrNo {
-			if len(t.expectedErrs) == 1 || !checkErr {
-				// !checkErr - Also keep old behavior, i.e. not use "Got one of the listed errors"
-				errStr := err.Error()
-				for _, reg := range t.replaceRegex {
-					errStr = reg.regex.ReplaceAllString(errStr, reg.replace)
-				}
-				fmt.Fprintf(&t.buf, "%s\n", strings.ReplaceAll(errStr, "\r", ""))
-			} else if strings.TrimSpace(t.expectedErrs[0]) != "0" {
-				fmt.Fprintf(&t.buf, "Got one of the listed errors\n")
-			}
-			return nil
-		}
-	}
-	if !checkErr {
-		gotErrCode := strconv.Itoa(errNo)
-		for k, v := range MysqlErrNameToNum {
-			if v == errNo {
-				gotErrCode = k
-				break
-			}
-		}
-		if len(t.expectedErrs) > 1 {
-			log.Warnf("%s:%d query failed with non expected error(s)! (%s not in %s) (err: %s) (query: %s)",
-				t.name, q.Line, gotErrCode, strings.Join(t.expectedErrs, ","), err.Error(), q.Query)
-		} else {
-			log.Warnf("%s:%d query failed with non expected error(s)! (%s != %s) (err: %s) (query: %s)",
-				t.name, q.Line, gotErrCode, t.expectedErrs[0], err.Error(), q.Query)
-		}
-		errStr := err.Error()
-		for _, reg := range t.replaceRegex {
-			errStr = reg.regex.ReplaceAllString(errStr, reg.replace)
-		}
-		fmt.Fprintf(&t.buf, "%s\n", strings.ReplaceAll(errStr, "\r", ""))
-		return nil
-	}
-	return err
-}
-
-func (t *tester) execute(query query) error {
-	if len(query.Query) == 0 {
-		return nil
-	}
-
-	offset := t.buf.Len()
-	err := t.stmtExecute(query)
-
-	err = t.checkExpectedError(query, err)
-	if err != nil {
-		return errors.Trace(errors.Errorf("run \"%v\" at line %d err %v", query.Query, query.Line, err))
-	}
-
-	// clear expected errors after we execute the first query
-	t.expectedErrs = nil
-
-	if !record {
-		// check test result now
-		gotBuf := t.buf.Bytes()[offset:]
-
-		buf := make([]byte, t.buf.Len()-offset)
-		if _, err = t.resultFD.ReadAt(buf, int64(offset)); err != nil {
-			return errors.Trace(errors.Errorf("run \"%v\" at line %d err, we got \n%s\nbut read result err %s", query.Query, query.Line, gotBuf, err))
-		}
-
-		if !bytes.Equal(gotBuf, buf) {
-			return errors.Trace(errors.Errorf("failed to run query \n\"%v\" \n around line %d, \nwe need(%v):\n%s\nbut got(%v):\n%s\n", query.Query, query.Line, len(buf), buf, len(gotBuf), gotBuf))
-		}
-	}
-
-	return errors.Trace(err)
-}
-
-func (t *tester) writeQueryResult(rows *byteRows) error {
-	if t.sortedResult {
-		sort.Sort(rows)
-	}
-
-	if len(t.replaceColumn) > 0 {
-		for _, row := range rows.data {
-			for _, r := range t.replaceColumn {
-				if len(row.data) < r.col {
-					continue
-				}
-				row.data[r.col-1] = r.replace
-			}
-		}
-	}
-
-	cols := rows.cols
-	for i, c := range cols {
-		t.buf.WriteString(c)
-		if i != len(cols)-1 {
-			t.buf.WriteString("\t")
+	if reference != "" {
+		ref, err := targetOptions(cfg, base, reference, given, false)
+		if err != nil {
+			return err
 		}
+		opts.Reference = &ref
 	}
-	t.buf.WriteString("\n")
-
-	for _, row := range rows.data {
-		var value string
-		for i, col := range row.data {
-			// replace result by regex
-			for _, reg := range t.replaceRegex {
-				col = reg.regex.ReplaceAll(col, []byte(reg.replace))
-			}
-
-			// Here we can check if the value is nil (NULL value)
-			if col == nil {
-				value = "NULL"
-			} else {
-				value = string(col)
-			}
-			t.buf.WriteString(value)
-			if i < len(row.data)-1 {
-				t.buf.WriteString("\t")
-			}
+	for _, name := range matrix {
+		t := tester.MatrixTarget{Name: name}
+		if t.Options, err = targetOptions(cfg, base, name, given, false); err != nil {
+			return err
 		}
-		t.buf.WriteString("\n")
+		matrixTargets = append(matrixTargets, t)
 	}
 	return nil
 }
 
-type byteRow struct {
-	data [][]byte
-}
-
-type byteRows struct {
-	cols []string
-	data []byteRow
-}
-
-func (rows *byteRows) Len() int {
-	return len(rows.data)
-}
-
-func (rows *byteRows) Less(i, j int) bool {
-	r1 := rows.data[i]
-	r2 := rows.data[j]
-	for i := 0; i < len(r1.data); i++ {
-		res := bytes.Compare(r1.data[i], r2.data[i])
-		switch res {
-		case -1:
-			return true
-		case 1:
-			return false
-		case 0:
-			// bytes.Compare(nil, []byte{}) returns 0
-			// But in sql row representation, they are NULL and empty string "" respectively, and thus not equal.
-			// So we need special logic to handle here: make NULL < ""
-			if r1.data[i] == nil && r2.data[i] != nil {
-				return true
-			}
-			if r1.data[i] != nil && r2.data[i] == nil {
-				return false
-			}
-		}
-	}
-	return false
-}
-
-func (rows *byteRows) Swap(i, j int) {
-	rows.data[i], rows.data[j] = rows.data[j], rows.data[i]
-}
-
-func dumpToByteRows(rows *sql.Rows) (*byteRows, error) {
-	cols, err := rows.Columns()
-	if err != nil {
-		return nil, errors.Trace(err)
+// targetOptions returns base with the target name of cfg applied, then the
+// given flags, without the server ones unless withServer is set. The flags
+// are bound to opts, which is restored.
+func targetOptions(cfg *tester.Config, base tester.Options, name string, given map[string]string, withServer bool) (tester.Options, error) {
+	saved := opts
+	defer func() {
+		opts = saved
+	}()
+	opts = base
+	if err := cfg.Apply(&opts, name); err != nil {
+		return tester.Options{}, err
 	}
-
-	data := make([]byteRow, 0, 8)
-	args := make([]interface{}, len(cols))
-	for {
-		for rows.Next() {
-			tmp := make([][]byte, len(cols))
-			for i := 0; i < len(args); i++ {
-				args[i] = &tmp[i]
-			}
-			err := rows.Scan(args...)
-			if err != nil {
-				return nil, errors.Trace(err)
-			}
-
-			data = append(data, byteRow{tmp})
+	for flagName, value := range given {
+		if serverFlags[flagName] && !withServer {
+			continue
 		}
-		if !rows.NextResultSet() {
-			break
+		if err := flag.Set(flagName, value); err != nil {
+			return tester.Options{}, err
 		}
 	}
-	err = rows.Err()
-	if err != nil {
-		return nil, errors.Trace(err)
-	}
-
-	return &byteRows{cols: cols, data: data}, nil
+	return opts, nil
 }
 
-func (t *tester) executeStmt(query string) error {
-	log.Debugf("executeStmt: %s", query)
-	raw, err := t.curr.conn.QueryContext(context.Background(), query)
-	if err != nil {
-		return errors.Trace(err)
+// runMatrix runs the tests against the targets of -matrix and writes their
+// compatibility matrix. The reference is the -reference target if set, the
+// one the tests run against otherwise.
+func runMatrix(tests []string) int {
+	ref := opts
+	if opts.Reference != nil {
+		ref = *opts.Reference
 	}
-
-	rows, err := dumpToByteRows(raw)
+	ref.Reference = nil
+	r := tester.NewRunner(ref)
+	handleSignals(r)
+	m, err := r.RunMatrix(tests, matrixTargets)
 	if err != nil {
-		return errors.Trace(err)
+		log.Fatal(err)
 	}
-
-	if t.enableResultLog && (len(rows.cols) > 0 || len(rows.data) > 0) {
-		if err = t.writeQueryResult(rows); err != nil {
-			return errors.Trace(err)
+	write := func(file string, fn func(io.Writer) error) {
+		var b bytes.Buffer
+		if err := fn(&b); err != nil {
+			log.Fatal(err)
 		}
-	}
-
-	if t.enableInfo {
-		err = t.curr.conn.Raw(func(driverConn any) error {
-			rowsAffected := driverConn.(*mysql.MysqlConn).RowsAffected()
-			lastMessage := driverConn.(*mysql.MysqlConn).LastMessage()
-			t.buf.WriteString(fmt.Sprintf("affected rows: %d\n", rowsAffected))
-			t.buf.WriteString(fmt.Sprintf("info: %s\n", lastMessage))
-			return nil
-		})
-		if err != nil {
-			log.Errorf("failed to get info: %s", err.Error())
+		if err := os.WriteFile(file, b.Bytes(), 0644); err != nil {
+			log.Fatalf("write matrix err %v", err)
 		}
 	}
-
-	if t.enableWarning {
-		raw, err := t.curr.conn.QueryContext(context.Background(), "show warnings")
-		if err != nil {
-			return errors.Trace(err)
-		}
-
-		rows, err := dumpToByteRows(raw)
-		if err != nil {
-			return errors.Trace(err)
-		}
-
-		if len(rows.data) > 0 {
-			sort.Sort(rows)
-			return t.writeQueryResult(rows)
-		}
+	if matrixMarkdown != "" {
+		write(matrixMarkdown, m.WriteMarkdown)
 	}
-	return nil
-}
-
-func (t *tester) executeStmtString(query string) (string, error) {
-	var result string
-	err := t.mdb.QueryRow(query).Scan(&result)
-	if err != nil {
-		return "", err
+	if matrixJSON != "" {
+		write(matrixJSON, m.WriteJSON)
 	}
-	return result, nil
-}
-
-func (t *tester) openResult() error {
-	if record {
-		return nil
-	}
-
-	var err error
-	t.resultFD, err = os.Open(t.resultFileName())
-	return err
-}
-
-func (t *tester) flushResult() error {
-	if !record {
-		return nil
-	}
-	path := t.resultFileName()
-	// Create all directories in the file path
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-		return fmt.Errorf("failed to create directories: %v", err)
-	}
-	return os.WriteFile(path, t.buf.Bytes(), 0644)
-}
-
-func (t *tester) testFileName() string {
-	// test and result must be in current ./t the same as MySQL
-	return fmt.Sprintf("./t/%s.test", t.name)
-}
-
-func hasCollationPrefix(name string) bool {
-	names := strings.Split(name, "/")
-	caseName := names[len(names)-1]
-	return strings.HasPrefix(caseName, "collation")
-}
-
-func (t *tester) resultFileName() string {
-	// test and result must be in current ./r, the same as MySQL
-	name := t.name
-	if hasCollationPrefix(name) {
-		if collationDisable {
-			name = name + "_disabled"
-		} else {
-			name = name + "_enabled"
-		}
-	}
-	return fmt.Sprintf("./r/%s.%s", name, extension)
-}
-
-func loadAllTests() ([]string, error) {
-	tests := make([]string, 0)
-	// tests must be in t folder or subdir in t folder
-	err := filepath.Walk("./t/", func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if !info.IsDir() && strings.HasSuffix(path, ".test") {
-			name := strings.TrimPrefix(strings.TrimSuffix(path, ".test"), "t/")
-			if !collationDisable || hasCollationPrefix(name) {
-				tests = append(tests, name)
-			}
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
-	return tests, nil
-}
-
-// convertTestsToTestTasks convert all test cases into several testBatches.
-// If we have 11 cases and batchSize is 5, then we will have 4 testBatches.
-func convertTestsToTestTasks(tests []string) (tTasks []testBatch, have_show, have_is bool) {
-	batchSize := 30
-	total := (len(tests) / batchSize) + 2
-	// the extra 1 is for sub_query_more test
-	tTasks = make([]testBatch, total+1)
-	testIdx := 0
-	have_subqmore, have_role := false, false
-	for i := 0; i < total; i++ {
-		tTasks[i] = make(testBatch, 0, batchSize)
-		for j := 0; j <= batchSize && testIdx < len(tests); j++ {
-			// skip sub_query_more test, since it consumes the most time
-			// we better use a separate goroutine to run it
-			// role test has many connection/disconnection operation.
-			// we better use a separate goroutine to run it
-			switch tests[testIdx] {
-			case "sub_query_more":
-				have_subqmore = true
-			case "show":
-				have_show = true
-			case "infoschema":
-				have_is = true
-			case "role":
-				have_role = true
-			case "role2":
-				have_role = true
-			default:
-				tTasks[i] = append(tTasks[i], tests[testIdx])
-			}
-			testIdx++
-		}
-	}
-
-	if have_subqmore {
-		tTasks[total-1] = testBatch{"sub_query_more"}
-	}
-
-	if have_role {
-		tTasks[total] = testBatch{"role", "role2"}
-	}
-	return
-}
-
-var msgs = make(chan testTask)
-var xmlFile *os.File
-var testSuite XUnitTestSuite
-
-type testTask struct {
-	err  error
-	test string
-}
-
-type testBatch []string
-
-func (t testBatch) Run() {
-	for _, test := range t {
-		tr := newTester(test)
-		msgs <- testTask{
-			test: test,
-			err:  tr.Run(),
+	if matrixMarkdown == "" && matrixJSON == "" {
+		if err := m.WriteMarkdown(os.Stdout); err != nil {
+			log.Fatal(err)
 		}
 	}
-}
-
-func (t testBatch) String() string {
-	return strings.Join([]string(t), ", ")
-}
-
-func executeTests(tasks []testBatch, have_show, have_is bool) {
-	// show and infoschema have to be executed first, since the following
-	// tests will create database using their own name.
-	if have_show {
-		show := newTester("show")
-		msgs <- testTask{
-			test: "show",
-			err:  show.Run(),
-		}
-	}
-
-	if have_is {
-		infoschema := newTester("infoschema")
-		msgs <- testTask{
-			test: "infoschema",
-			err:  infoschema.Run(),
-		}
-	}
-
-	for _, t := range tasks {
-		t.Run()
-	}
-}
-
-func consumeError() []error {
-	var es []error
-	for {
-		if t, more := <-msgs; more {
-			if t.err != nil {
-				e := fmt.Errorf("run test [%s] err: %v", t.test, t.err)
-				log.Errorln(e)
-				es = append(es, e)
-			} else {
-				log.Infof("run test [%s] ok", t.test)
-			}
-		} else {
-			return es
-		}
+	if r.Interrupted() {
+		log.Error("the run was interrupted")
+		return interruptedExitCode
 	}
+	return 0
 }
 
 func main() {
 	flag.Parse()
 	tests := flag.Args()
-	startTime := time.Now()
+	flag.Visit(func(f *flag.Flag) {
+		opts.Flags = append(opts.Flags, f.Name)
+	})
 	if ll := os.Getenv("LOG_LEVEL"); ll != "" {
 		logLevel = ll
 	}
@@ -1273,80 +322,49 @@ func main() {
 		log.SetLevel(ll)
 	}
 
-	if xmlPath != "" {
-		_, err := os.Stat(xmlPath)
-		if err == nil {
-			err = os.Remove(xmlPath)
-			if err != nil {
-				log.Error("drop previous xunit file fail: ", err)
-				os.Exit(1)
-			}
+	if configFile != "" {
+		if err := applyConfig(); err != nil {
+			log.Fatalf("load config err %v", err)
 		}
+	} else if target != "" || reference != "" || len(matrix) > 0 {
+		log.Fatal("-target, -reference and -matrix need -config")
+	}
 
-		xmlFile, err = os.Create(xmlPath)
-		if err != nil {
-			log.Error("create xunit file fail:", err)
-			os.Exit(1)
-		}
-		xmlFile, err = os.OpenFile(xmlPath, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0600)
-		if err != nil {
-			log.Error("open xunit file fail:", err)
-			os.Exit(1)
-		}
+	var err error
+	if opts.Color, err = tester.ParseColorMode(colorMode); err != nil {
+		log.Fatal(err)
+	}
 
-		testSuite = XUnitTestSuite{
-			Name:       "",
-			Tests:      0,
-			Failures:   0,
-			Properties: make([]XUnitProperty, 0),
-			TestCases:  make([]XUnitTestCase, 0),
+	if len(opts.ErrorCatalogs) > 0 {
+		if err := tester.LoadErrorCatalogs(opts.ErrorCatalogs); err != nil {
+			log.Fatalf("load error catalog err %v", err)
 		}
-
-		defer func() {
-			if xmlFile != nil {
-				testSuite.Tests = len(tests)
-				testSuite.Time = fmt.Sprintf("%fs", time.Since(startTime).Seconds())
-				testSuite.Properties = append(testSuite.Properties, XUnitProperty{
-					Name:  "go.version",
-					Value: goVersion(),
-				})
-				err := Write(xmlFile, testSuite)
-				if err != nil {
-					log.Error("Write xunit file fail:", err)
-				}
-			}
-		}()
 	}
 
-	// we will run all tests if no tests assigned
-	if len(tests) == 0 {
-		var err error
-		if tests, err = loadAllTests(); err != nil {
-			log.Fatalf("load all tests err %v", err)
+	if len(tests) > 0 {
+		if cmd, ok := subcommands[tests[0]]; ok {
+			os.Exit(cmd(tests[1:]))
 		}
 	}
 
-	if !record {
-		log.Infof("running tests: %v", tests)
-	} else {
-		log.Infof("recording tests: %v", tests)
+	if len(matrix) > 0 {
+		os.Exit(runMatrix(tests))
 	}
 
-	if !checkErr {
-		log.Warn("--check-error is not set! --error in .test file will simply accept zero or more errors! (i.e. not even check for errors!)")
+	r := tester.NewRunner(opts)
+	handleSignals(r)
+	// we will run all tests if no tests assigned
+	summary, err := r.Run(tests)
+	if err != nil {
+		log.Fatal(err)
 	}
-	go func() {
-		executeTests(convertTestsToTestTasks(tests))
-		close(msgs)
-	}()
-
-	es := consumeError()
 	println()
-	if len(es) != 0 {
-		log.Errorf("%d tests failed\n", len(es))
-		for _, item := range es {
-			log.Errorln(item)
-		}
+	summary.Print()
+	if summary.Interrupted {
+		log.Error("the run was interrupted")
+		os.Exit(interruptedExitCode)
+	}
+	if summary.Failed() {
 		// Can't delete this statement.
 		os.Exit(1)
 	} else {
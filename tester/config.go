@@ -0,0 +1,247 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a configuration file describing the servers the tests run
+// against, as named targets, and the defaults of the runs:
+//
+//	default_target: tidb
+//	defaults:
+//	  check_error: true
+//	  parallel: 4
+//	  test_timeout: 5m
+//	targets:
+//	  tidb:
+//	    host: 127.0.0.1
+//	    port: 4000
+//	    user: root
+//	    password_env: TIDB_PASSWORD
+//	    params:
+//	      tidb_cost_model_version: 2
+//	    init_sql:
+//	      - SET @@tidb_enable_clustered_index = 'int_only'
+//	  mysql:
+//	    port: 3306
+//	    time_zone: UTC
+//	    extension: mysql.result
+//	    error_catalogs: [errors/mysqld_ername.h]
+//	  engine:
+//	    port: 3307
+//	    dialect: engine
+//	    capabilities: [ps_protocol]
+//
+// Relative file names are relative to the configuration file.
+type Config struct {
+	DefaultTarget string                   `yaml:"default_target"`
+	Defaults      ConfigDefaults           `yaml:"defaults"`
+	Targets       map[string]*ConfigTarget `yaml:"targets"`
+
+	// dir is the directory of the configuration file.
+	dir string
+}
+
+// ConfigDefaults are the settings of the runs, whatever the target.
+type ConfigDefaults struct {
+	Extension     *string        `yaml:"extension"`
+	CheckErr      *bool          `yaml:"check_error"`
+	ReserveSchema *bool          `yaml:"reserve_schema"`
+	Parallel      *int           `yaml:"parallel"`
+	Retries       *int           `yaml:"retries"`
+	StmtTimeout   *time.Duration `yaml:"stmt_timeout"`
+	TestTimeout   *time.Duration `yaml:"test_timeout"`
+	AllMismatches *bool          `yaml:"all_mismatches"`
+	DiffContext   *int           `yaml:"diff_context"`
+}
+
+// ConfigTarget is a server the tests run against. Its password is given by
+// one of Password, PasswordEnv, the environment variable holding it, and
+// PasswordFile, the file holding it.
+type ConfigTarget struct {
+	Host           string            `yaml:"host"`
+	Port           string            `yaml:"port"`
+	User           string            `yaml:"user"`
+	Password       string            `yaml:"password"`
+	PasswordEnv    string            `yaml:"password_env"`
+	PasswordFile   string            `yaml:"password_file"`
+	Params         map[string]string `yaml:"params"`
+	TimeZone       *string           `yaml:"time_zone"`
+	AllowAllFiles  *bool             `yaml:"allow_all_files"`
+	InitSQL        []string          `yaml:"init_sql"`
+	Extension      string            `yaml:"extension"`
+	ErrorCatalogs  []string          `yaml:"error_catalogs"`
+	RetryConnCount *int              `yaml:"retry_connection_count"`
+	Dialect        string            `yaml:"dialect"`
+	Capabilities   []string          `yaml:"capabilities"`
+}
+
+// LoadConfig reads the configuration file.
+func LoadConfig(file string) (*Config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cfg := &Config{dir: filepath.Dir(file)}
+	if err = yaml.Unmarshal(data, cfg); err != nil {
+		return nil, errors.Annotatef(err, "parse config file %s", file)
+	}
+	for name, target := range cfg.Targets {
+		if target == nil {
+			return nil, errors.Errorf("%s: target %s is empty", file, name)
+		}
+		sources := 0
+		for _, s := range []string{target.Password, target.PasswordEnv, target.PasswordFile} {
+			if s != "" {
+				sources++
+			}
+		}
+		if sources > 1 {
+			return nil, errors.Errorf("%s: target %s must have only one of password, password_env and password_file", file, name)
+		}
+	}
+	for name, target := range cfg.Targets {
+		if _, err = ParseCapabilities(target.Capabilities); err != nil {
+			return nil, errors.Annotatef(err, "%s: target %s", file, name)
+		}
+	}
+	if cfg.DefaultTarget != "" && cfg.Targets[cfg.DefaultTarget] == nil {
+		return nil, errors.Errorf("%s: unknown default_target %s", file, cfg.DefaultTarget)
+	}
+	return cfg, nil
+}
+
+// TargetNames returns the names of the targets, sorted.
+func (c *Config) TargetNames() []string {
+	names := make([]string, 0, len(c.Targets))
+	for name := range c.Targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Apply sets opts from the defaults and the target name, the default target
+// if name is empty. There is no target to apply if the file has neither.
+func (c *Config) Apply(opts *Options, name string) error {
+	d := c.Defaults
+	setIf(&opts.Extension, d.Extension)
+	setIf(&opts.CheckErr, d.CheckErr)
+	setIf(&opts.ReserveSchema, d.ReserveSchema)
+	setIf(&opts.Parallel, d.Parallel)
+	setIf(&opts.Retries, d.Retries)
+	setIf(&opts.StmtTimeout, d.StmtTimeout)
+	setIf(&opts.TestTimeout, d.TestTimeout)
+	setIf(&opts.AllMismatches, d.AllMismatches)
+	setIf(&opts.DiffContext, d.DiffContext)
+
+	if name == "" {
+		name = c.DefaultTarget
+	}
+	if name == "" {
+		return nil
+	}
+	t, ok := c.Targets[name]
+	if !ok {
+		return errors.Errorf("unknown target %s, the targets are %s", name, strings.Join(c.TargetNames(), ", "))
+	}
+	opts.Target = name
+	if t.Host != "" {
+		opts.Host = t.Host
+	}
+	if t.Port != "" {
+		opts.Port = t.Port
+	}
+	if t.User != "" {
+		opts.User = t.User
+	}
+	password, err := t.password(c)
+	if err != nil {
+		return errors.Annotatef(err, "password of target %s", name)
+	}
+	opts.Password = password
+	opts.Params = encodeParams(t.Params)
+	setIf(&opts.TimeZone, t.TimeZone)
+	setIf(&opts.AllowAllFiles, t.AllowAllFiles)
+	setIf(&opts.RetryConnCount, t.RetryConnCount)
+	opts.InitSQL = t.InitSQL
+	opts.Dialect = t.Dialect
+	opts.Capabilities = t.Capabilities
+	if t.Extension != "" {
+		opts.Extension = t.Extension
+	}
+	opts.ErrorCatalogs = nil
+	for _, file := range t.ErrorCatalogs {
+		opts.ErrorCatalogs = append(opts.ErrorCatalogs, c.path(file))
+	}
+	return nil
+}
+
+func (t *ConfigTarget) password(c *Config) (string, error) {
+	switch {
+	case t.PasswordEnv != "":
+		password, ok := os.LookupEnv(t.PasswordEnv)
+		if !ok {
+			return "", errors.Errorf("environment variable %s is not set", t.PasswordEnv)
+		}
+		return password, nil
+	case t.PasswordFile != "":
+		data, err := os.ReadFile(c.path(t.PasswordFile))
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return t.Password, nil
+}
+
+// path resolves file relatively to the configuration file.
+func (c *Config) path(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(c.dir, file)
+}
+
+// encodeParams turns DSN parameters into the Params of the options,
+// e.g. "&a=1&b=2".
+func encodeParams(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "&%s=%s", k, url.QueryEscape(params[k]))
+	}
+	return b.String()
+}
+
+func setIf[T any](dst *T, src *T) {
+	if src != nil {
+		*dst = *src
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "mysql-tester.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+default_target: tidb
+defaults:
+  check_error: true
+  test_timeout: 5m
+targets:
+  tidb:
+    port: 4000
+    password_env: TEST_TIDB_PASSWORD
+    params:
+      tidb_cost_model_version: 2
+      sql_mode: "'STRICT_TRANS_TABLES'"
+    init_sql:
+      - SET @@tidb_enable_clustered_index = 'int_only'
+  mysql:
+    host: mysql
+    password_file: secret
+    time_zone: UTC
+    allow_all_files: false
+    extension: mysql.result
+    error_catalogs: [errors.h]
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret"), []byte("s3cret\n"), 0600))
+	cfg, err := LoadConfig(file)
+	require.NoError(t, err)
+	require.Equal(t, []string{"mysql", "tidb"}, cfg.TargetNames())
+
+	t.Setenv("TEST_TIDB_PASSWORD", "pw")
+	opts := DefaultOptions()
+	require.NoError(t, cfg.Apply(&opts, ""))
+	require.Equal(t, "tidb", opts.Target)
+	require.Equal(t, "127.0.0.1", opts.Host)
+	require.Equal(t, "4000", opts.Port)
+	require.Equal(t, "pw", opts.Password)
+	require.Equal(t, "&sql_mode=%27STRICT_TRANS_TABLES%27&tidb_cost_model_version=2", opts.Params)
+	require.Equal(t, []string{"SET @@tidb_enable_clustered_index = 'int_only'"}, opts.InitSQL)
+	require.True(t, opts.CheckErr)
+	require.Equal(t, 5*time.Minute, opts.TestTimeout)
+	require.Equal(t, "Asia/Shanghai", opts.TimeZone)
+
+	opts = DefaultOptions()
+	require.NoError(t, cfg.Apply(&opts, "mysql"))
+	require.Equal(t, "mysql", opts.Host)
+	require.Equal(t, "s3cret", opts.Password)
+	require.Equal(t, "UTC", opts.TimeZone)
+	require.False(t, opts.AllowAllFiles)
+	require.Equal(t, "mysql.result", opts.Extension)
+	require.Equal(t, []string{filepath.Join(dir, "errors.h")}, opts.ErrorCatalogs)
+
+	require.Error(t, cfg.Apply(&opts, "oracle"))
+
+	require.NoError(t, os.WriteFile(file, []byte(`
+targets:
+  tidb:
+    password: a
+    password_env: B
+`), 0644))
+	_, err = LoadConfig(file)
+	require.Error(t, err)
+}
+
+func TestBuildDSN(t *testing.T) {
+	opts := DefaultOptions()
+	const prefix = "root:@tcp(127.0.0.1:3306)/test?time_zone=%27Asia/Shanghai%27&allowAllFiles=true"
+	require.Equal(t, prefix, newConnManager(&opts).buildDSN("root", "", "127.0.0.1", "test"))
+	// params from the command line or MYSQL_TESTER_PARAMS, with or without
+	// a leading &, and from a configuration file
+	for _, params := range []string{"tidb_cost_model_version=2", "&tidb_cost_model_version=2", encodeParams(map[string]string{"tidb_cost_model_version": "2"})} {
+		opts.Params = params
+		require.Equal(t, prefix+"&tidb_cost_model_version=2", newConnManager(&opts).buildDSN("root", "", "127.0.0.1", "test"))
+	}
+}
+
+func TestDefaultInitSQL(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "mysql-tester.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+targets:
+  tidb:
+    port: 4000
+  tidb-plain:
+    dialect: tidb
+    init_sql: []
+  mysql:
+    port: 3306
+`), 0644))
+	cfg, err := LoadConfig(file)
+	require.NoError(t, err)
+	initSQL := func(name string) []string {
+		opts := DefaultOptions()
+		require.NoError(t, cfg.Apply(&opts, name))
+		return newConnManager(&opts).initSQL
+	}
+	require.Equal(t, DefaultTiDBInitSQL, initSQL("tidb"))
+	require.Empty(t, initSQL("tidb-plain"))
+	require.Empty(t, initSQL("mysql"))
+
+	opts := DefaultOptions()
+	opts.Dialect = "TiDB"
+	require.Equal(t, DefaultTiDBInitSQL, opts.initSQL())
+	// the suite statements run after the default ones
+	sc := &suiteConfig{files: []*suiteFile{{InitSQL: []string{"SET @a = 1"}}}}
+	sc.apply(&opts)
+	require.Equal(t, append(append([]string{}, DefaultTiDBInitSQL...), "SET @a = 1"), opts.InitSQL)
+}
@@ -1,11 +1,12 @@
 // Copyright 2025
 
-package main
+package tester
 
 import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -14,13 +15,15 @@ import (
 
 // ConnectionManager 负责管理数据库连接池
 type ConnectionManager struct {
-	connections map[string]*Conn
-	currentConn *Conn
-	defaultPort      string
-	defaultParams    string
-	retryConnCount   int
-	defaultTimeZone  string
-	allowAllFiles    bool
+	connections     map[string]*Conn
+	currentConn     *Conn
+	defaultPort     string
+	defaultParams   string
+	retryConnCount  int
+	defaultTimeZone string
+	allowAllFiles   bool
+	// initSQL are run on every new connection.
+	initSQL []string
 }
 
 // NewConnectionManager 创建一个新的连接管理器实例
@@ -55,51 +58,40 @@ func (cm *ConnectionManager) SetCurrentConnection(conn *Conn) {
 }
 
 // AddConnection 添加一个新的数据库连接
-func (cm *ConnectionManager) AddConnection(connName, hostName, userName, password, db string, expectedErrs []string) (*Conn, error) {
+func (cm *ConnectionManager) AddConnection(connName, hostName, userName, password, db string, expectErr bool) (*Conn, error) {
 	var (
 		mdb *sql.DB
 		err error
 	)
 
-	
 	if cm.currentConn != nil &&
 		cm.currentConn.hostName == hostName &&
 		cm.currentConn.userName == userName &&
 		cm.currentConn.password == password &&
-		expectedErrs == nil {
-		
+		!expectErr {
+
 		mdb = cm.currentConn.mdb
 	} else {
-		
+
 		dsn := cm.buildDSN(userName, password, hostName, db)
-		
-		
+
 		retryCount := cm.retryConnCount
-		if expectedErrs != nil {
+		if expectErr {
 			retryCount = 1
 		}
-		
-		
+
 		mdb, err = cm.openDBWithRetry("mysql", dsn, retryCount)
 	}
 
 	if err != nil {
-		if expectedErrs == nil {
-			log.Fatalf("Open db err %v", err)
-		}
 		return nil, err
 	}
 
-	
-	conn, err := cm.initConn(mdb, userName, password, hostName, db)
+	conn, err := cm.initConn(connName, mdb, userName, password, hostName, db)
 	if err != nil {
-		if expectedErrs == nil {
-			log.Fatalf("Init conn err %v", err)
-		}
 		return nil, err
 	}
 
-	
 	cm.connections[connName] = conn
 	cm.currentConn = conn
 	return conn, nil
@@ -122,12 +114,10 @@ func (cm *ConnectionManager) DisconnectConnection(connName string) error {
 		return fmt.Errorf("connection %s not found", connName)
 	}
 
-	
 	if cm.currentConn == conn {
 		cm.currentConn = nil
 	}
 
-	
 	if conn.conn != nil {
 		if err := conn.conn.Close(); err != nil {
 			return err
@@ -135,7 +125,6 @@ func (cm *ConnectionManager) DisconnectConnection(connName string) error {
 		conn.conn = nil
 	}
 
-	
 	delete(cm.connections, connName)
 	return nil
 }
@@ -152,16 +141,21 @@ func (cm *ConnectionManager) CloseAllConnections() {
 }
 
 // buildDSN 构建数据库连接字符串
+// the additional params follow the default ones, with or without a leading &
 func (cm *ConnectionManager) buildDSN(userName, password, hostName, db string) string {
+	params := cm.defaultParams
+	if params != "" && !strings.HasPrefix(params, "&") {
+		params = "&" + params
+	}
 	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?time_zone=%%27%s%%27&allowAllFiles=%t%s",
-		userName, password, hostName, cm.defaultPort, db, cm.defaultTimeZone, cm.allowAllFiles, cm.defaultParams)
+		userName, password, hostName, cm.defaultPort, db, cm.defaultTimeZone, cm.allowAllFiles, params)
 }
 
 // openDBWithRetry 打开数据库连接并在失败时进行重试
 func (cm *ConnectionManager) openDBWithRetry(driverName, dataSourceName string, retryCount int) (mdb *sql.DB, err error) {
 	startTime := time.Now()
 	sleepTime := time.Millisecond * 500
-	
+
 	for i := 0; i < retryCount; i++ {
 		mdb, err = sql.Open(driverName, dataSourceName)
 		if err != nil {
@@ -186,7 +180,7 @@ func (cm *ConnectionManager) openDBWithRetry(driverName, dataSourceName string,
 }
 
 // initConn 初始化数据库连接
-func (cm *ConnectionManager) initConn(mdb *sql.DB, userName, password, hostName, dbName string) (*Conn, error) {
+func (cm *ConnectionManager) initConn(connName string, mdb *sql.DB, userName, password, hostName, dbName string) (*Conn, error) {
 	conn := &Conn{
 		mdb:      mdb,
 		hostName: hostName,
@@ -195,12 +189,23 @@ func (cm *ConnectionManager) initConn(mdb *sql.DB, userName, password, hostName,
 		db:       dbName,
 	}
 
-	
 	sqlConn, err := mdb.Conn(context.Background())
 	if err != nil {
 		return nil, err
 	}
 	conn.conn = sqlConn
 
+	// 记录连接ID，用于超时时通过旁路连接执行KILL QUERY
+	if err := sqlConn.QueryRowContext(context.Background(), "SELECT CONNECTION_ID()").Scan(&conn.connID); err != nil {
+		log.Warnf("Get connection id err %v", err)
+	}
+	// the init SQL of the target and of the suite, for every connection
+	for i, stmt := range cm.initSQL {
+		if _, err := sqlConn.ExecContext(context.Background(), stmt); err != nil {
+			sqlConn.Close()
+			return nil, errors.Annotatef(err, "connection %s: init SQL #%d %q", connName, i+1, stmt)
+		}
+	}
+
 	return conn, nil
 }
@@ -0,0 +1,473 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Capability is a feature of a server which some commands need.
+type Capability string
+
+// Capabilities known to the dialects.
+const (
+	// CapPSProtocol is the binary protocol of prepared statements.
+	CapPSProtocol Capability = "ps_protocol"
+	// CapSessionTrack is the tracking of the session state changes.
+	CapSessionTrack Capability = "session_track"
+	// CapResetConnection is COM_RESET_CONNECTION.
+	CapResetConnection Capability = "reset_connection"
+	// CapGTID is the replication by global transaction identifiers.
+	CapGTID Capability = "gtid"
+)
+
+// Capabilities lists the known capabilities.
+var Capabilities = []Capability{CapPSProtocol, CapSessionTrack, CapResetConnection, CapGTID}
+
+// Names of the built-in dialects.
+const (
+	DialectMySQL   = "mysql"
+	DialectTiDB    = "tidb"
+	DialectMariaDB = "mariadb"
+	DialectGeneric = "generic"
+)
+
+// Dialect is the kind of server the tests run against.
+type Dialect interface {
+	// Name is the name of the dialect, e.g. mysql or tidb.
+	Name() string
+	// Version is the version of the server, the one of TiDB itself rather
+	// than the MySQL version it reports for TiDB.
+	Version() Version
+	// Supports reports whether the server has the capability.
+	Supports(c Capability) bool
+}
+
+type baseDialect struct {
+	name    string
+	version Version
+}
+
+func (d baseDialect) Name() string { return d.name }
+
+func (d baseDialect) Version() Version { return d.version }
+
+type mysqlDialect struct{ baseDialect }
+
+func (d mysqlDialect) Supports(c Capability) bool {
+	switch c {
+	case CapPSProtocol:
+		return true
+	case CapSessionTrack:
+		return d.version.AtLeast(5, 7)
+	case CapResetConnection:
+		return d.version.AtLeast(5, 7, 3)
+	case CapGTID:
+		return d.version.AtLeast(5, 6)
+	}
+	return false
+}
+
+type tidbDialect struct{ baseDialect }
+
+func (d tidbDialect) Supports(c Capability) bool {
+	switch c {
+	case CapPSProtocol, CapResetConnection:
+		return true
+	}
+	return false
+}
+
+type mariadbDialect struct{ baseDialect }
+
+func (d mariadbDialect) Supports(c Capability) bool {
+	switch c {
+	case CapPSProtocol:
+		return true
+	case CapSessionTrack:
+		return d.version.AtLeast(10, 2)
+	case CapResetConnection:
+		return d.version.AtLeast(10, 2, 4)
+	case CapGTID:
+		return d.version.AtLeast(10, 0)
+	}
+	return false
+}
+
+// genericDialect is a server the probes could not identify, or which was
+// named by the options, e.g. our own engine. It only has the capabilities
+// it is given.
+type genericDialect struct {
+	baseDialect
+	caps map[Capability]bool
+}
+
+func (d genericDialect) Supports(c Capability) bool { return d.caps[c] }
+
+// capsDialect replaces the capabilities of a dialect by the ones given by
+// the options.
+type capsDialect struct {
+	Dialect
+	caps map[Capability]bool
+}
+
+func (d capsDialect) Supports(c Capability) bool { return d.caps[c] }
+
+// newDialect returns the dialect name of the server of version, with caps
+// instead of the capabilities it is known to have if caps is not nil.
+func newDialect(name string, version Version, caps []Capability) Dialect {
+	base := baseDialect{name: name, version: version}
+	var d Dialect
+	switch name {
+	case DialectMySQL:
+		d = mysqlDialect{base}
+	case DialectTiDB:
+		d = tidbDialect{base}
+	case DialectMariaDB:
+		d = mariadbDialect{base}
+	default:
+		d = genericDialect{baseDialect: base}
+	}
+	if caps != nil {
+		set := make(map[Capability]bool, len(caps))
+		for _, c := range caps {
+			set[c] = true
+		}
+		d = capsDialect{Dialect: d, caps: set}
+	}
+	return d
+}
+
+// ParseCapabilities parses capability names.
+func ParseCapabilities(names []string) ([]Capability, error) {
+	caps := make([]Capability, 0, len(names))
+	for _, name := range names {
+		c, err := parseCapability(name)
+		if err != nil {
+			return nil, err
+		}
+		caps = append(caps, c)
+	}
+	return caps, nil
+}
+
+func parseCapability(name string) (Capability, error) {
+	for _, c := range Capabilities {
+		if string(c) == strings.ToLower(name) {
+			return c, nil
+		}
+	}
+	return "", errors.Errorf("unknown capability %s", name)
+}
+
+// detectDialect probes the server of conn: the dialect named by opts, else
+// TiDB if it has tidb_version(), MariaDB if its version says so, MySQL if
+// its version comment does, and generic otherwise.
+func detectDialect(ctx context.Context, conn *sql.Conn, opts *Options) (Dialect, error) {
+	var caps []Capability
+	if opts.Capabilities != nil {
+		var err error
+		if caps, err = ParseCapabilities(opts.Capabilities); err != nil {
+			return nil, err
+		}
+	}
+	var version, comment string
+	if err := conn.QueryRowContext(ctx, "SELECT VERSION()").Scan(&version); err != nil {
+		log.Warnf("probe server version err %v", err)
+	}
+	if err := conn.QueryRowContext(ctx, "SELECT @@version_comment").Scan(&comment); err != nil {
+		log.Debugf("probe server version comment err %v", err)
+	}
+	name := strings.ToLower(opts.Dialect)
+	if name == "" {
+		var tidbVersion string
+		switch {
+		case conn.QueryRowContext(ctx, "SELECT tidb_version()").Scan(&tidbVersion) == nil:
+			name = DialectTiDB
+		case strings.Contains(version, "MariaDB"):
+			name = DialectMariaDB
+		case strings.Contains(comment, "MySQL"), strings.Contains(comment, "Percona"):
+			name = DialectMySQL
+		default:
+			name = DialectGeneric
+		}
+	}
+	return newDialect(name, serverVersion(name, version), caps), nil
+}
+
+// serverVersion returns the version of the server of the dialect name from
+// its VERSION(), e.g. 7.5.0 for 8.0.11-TiDB-v7.5.0 and 10.6.12 for
+// 5.5.5-10.6.12-MariaDB.
+func serverVersion(name, version string) Version {
+	switch name {
+	case DialectTiDB:
+		if _, v, ok := strings.Cut(version, "-TiDB-v"); ok {
+			version = v
+		}
+	case DialectMariaDB:
+		version = strings.TrimPrefix(version, "5.5.5-")
+	}
+	v, _ := parseVersionPrefix(version)
+	return v
+}
+
+// Version is a server version, e.g. 8.0.35 as [8 0 35].
+type Version []int
+
+func (v Version) String() string {
+	parts := make([]string, len(v))
+	for i, n := range v {
+		parts[i] = strconv.Itoa(n)
+	}
+	return strings.Join(parts, ".")
+}
+
+// Compare returns -1, 0 or 1 as v is lower, equal or greater than o, the
+// missing components being 0.
+func (v Version) Compare(o Version) int {
+	for i := 0; i < len(v) || i < len(o); i++ {
+		var a, b int
+		if i < len(v) {
+			a = v[i]
+		}
+		if i < len(o) {
+			b = o[i]
+		}
+		if a != b {
+			if a < b {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// AtLeast reports whether v is at least the version of the components.
+func (v Version) AtLeast(components ...int) bool {
+	return v.Compare(Version(components)) >= 0
+}
+
+// ParseVersion parses a version such as 8.0 or 8.0.35.
+func ParseVersion(s string) (Version, error) {
+	v, rest := parseVersionPrefix(s)
+	if len(v) == 0 || rest != "" {
+		return nil, errors.Errorf("invalid version %q", s)
+	}
+	return v, nil
+}
+
+// parseVersionPrefix parses the leading dot separated numbers of s and
+// returns the rest.
+func parseVersionPrefix(s string) (Version, string) {
+	var v Version
+	for {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		if i == 0 {
+			return v, s
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return v, s
+		}
+		v = append(v, n)
+		s = s[i:]
+		if len(s) < 2 || s[0] != '.' || s[1] < '0' || s[1] > '9' {
+			return v, s
+		}
+		s = s[1:]
+	}
+}
+
+// versionRequirement is the argument of --require_version, e.g. >= 8.0.
+// It is checked against the Version of the dialect, the TiDB version on
+// TiDB, see serverVersion.
+type versionRequirement struct {
+	op      string
+	version Version
+}
+
+// parseVersionRequirement parses an operator among >=, >, <=, <, = and !=
+// followed by a version, = if there is none.
+func parseVersionRequirement(s string) (versionRequirement, error) {
+	s = strings.TrimSpace(s)
+	req := versionRequirement{op: "="}
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if strings.HasPrefix(s, op) {
+			req.op = op
+			s = strings.TrimSpace(s[len(op):])
+			break
+		}
+	}
+	v, err := ParseVersion(s)
+	if err != nil {
+		return req, err
+	}
+	req.version = v
+	return req, nil
+}
+
+func (r versionRequirement) match(v Version) bool {
+	c := v.Compare(r.version)
+	switch r.op {
+	case ">=":
+		return c >= 0
+	case ">":
+		return c > 0
+	case "<=":
+		return c <= 0
+	case "<":
+		return c < 0
+	case "!=":
+		return c != 0
+	}
+	return c == 0
+}
+
+func (r versionRequirement) String() string {
+	return fmt.Sprintf("%s %s", r.op, r.version)
+}
+
+// Dialect returns the dialect of the server, detected when the run started,
+// or by the first test connecting to it with RunTest, nil before.
+func (r *Runner) Dialect() Dialect {
+	r.dialectLock.Lock()
+	defer r.dialectLock.Unlock()
+	return r.dialects[r.opts.server()]
+}
+
+// detectServerDialect connects to the server of opts to detect its
+// dialect, unless it is known already. The runs do it before their first
+// test, so that a server which can not be reached fails the run at once.
+func (r *Runner) detectServerDialect(opts *Options) (Dialect, error) {
+	r.dialectLock.Lock()
+	d, ok := r.dialects[opts.server()]
+	r.dialectLock.Unlock()
+	if ok {
+		return d, nil
+	}
+	cm := newConnManager(opts)
+	// the init SQL may depend on the dialect
+	cm.initSQL = nil
+	conn, err := cm.AddConnection(default_connection, opts.Host, opts.User, opts.Password, "", false)
+	if err != nil {
+		return nil, errors.Annotatef(err, "connect to %s", opts.server())
+	}
+	defer cm.CloseAllConnections()
+	ctx, cancel := runStmtContext(opts)
+	defer cancel()
+	d, err = r.detectDialect(ctx, conn, opts)
+	return d, errors.Annotatef(err, "detect the dialect of %s", opts.server())
+}
+
+// detectDialect probes the server of conn, the one of opts, once per run.
+func (r *Runner) detectDialect(ctx context.Context, conn *Conn, opts *Options) (Dialect, error) {
+	r.dialectLock.Lock()
+	defer r.dialectLock.Unlock()
+	if d, ok := r.dialects[opts.server()]; ok {
+		return d, nil
+	}
+	d, err := detectDialect(ctx, conn.conn, opts)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("server %s dialect %s, version %s", opts.server(), d.Name(), d.Version())
+	r.dialects[opts.server()] = d
+	return d, nil
+}
+
+// SkipError is the error of a test which skipped itself, e.g. because the
+// server lacks what it requires.
+type SkipError struct {
+	Reason string
+}
+
+func (e *SkipError) Error() string {
+	return "skipped: " + e.Reason
+}
+
+func skipReason(err error) (string, bool) {
+	if e, ok := errors.Cause(err).(*SkipError); ok {
+		return e.Reason, true
+	}
+	return "", false
+}
+
+func isSkipError(err error) bool {
+	_, ok := skipReason(err)
+	return ok
+}
+
+// checkRequire skips the test if the server does not satisfy the
+// --require_dialect, --require_version or --require_capability command q.
+func (t *Tester) checkRequire(q Query) error {
+	d, err := t.r.detectDialect(t.ctx, t.curr, t.opts)
+	if err != nil {
+		return errors.Annotatef(err, "line %d", q.Line)
+	}
+	arg := strings.TrimSpace(q.Query)
+	switch q.tp {
+	case Q_REQUIRE_DIALECT:
+		names := SplitList(arg)
+		if len(names) == 0 {
+			return errors.Errorf("line %d: --require_dialect needs dialect names", q.Line)
+		}
+		for _, name := range names {
+			if strings.EqualFold(name, d.Name()) {
+				return nil
+			}
+		}
+		return &SkipError{Reason: fmt.Sprintf("requires dialect %s, the server is %s", strings.Join(names, ", "), d.Name())}
+	case Q_REQUIRE_VERSION:
+		req, err := parseVersionRequirement(arg)
+		if err != nil {
+			return errors.Annotatef(err, "line %d: --require_version", q.Line)
+		}
+		if !req.match(d.Version()) {
+			return &SkipError{Reason: fmt.Sprintf("requires version %s, the server is %s %s", req, d.Name(), d.Version())}
+		}
+	case Q_REQUIRE_CAPABILITY:
+		c, err := parseCapability(arg)
+		if err != nil {
+			return errors.Annotatef(err, "line %d: --require_capability", q.Line)
+		}
+		return t.requireCapability(d, c)
+	}
+	return nil
+}
+
+// commandCapabilities are the capabilities the commands need, a test using
+// one of them is skipped on a server which lacks it.
+var commandCapabilities = map[int]Capability{
+	Q_ENABLE_PS_PROTOCOL:        CapPSProtocol,
+	Q_ENABLE_SESSION_TRACK_INFO: CapSessionTrack,
+	Q_RESET_CONNECTION:          CapResetConnection,
+}
+
+func (t *Tester) requireCapability(d Dialect, c Capability) error {
+	if d.Supports(c) {
+		return nil
+	}
+	return &SkipError{Reason: fmt.Sprintf("requires %s, which the %s server lacks", c, d.Name())}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerVersion(t *testing.T) {
+	require.Equal(t, Version{8, 0, 35}, serverVersion(DialectMySQL, "8.0.35"))
+	require.Equal(t, Version{8, 0, 35}, serverVersion(DialectMySQL, "8.0.35-0ubuntu0.22.04.1"))
+	require.Equal(t, Version{7, 5, 0}, serverVersion(DialectTiDB, "8.0.11-TiDB-v7.5.0"))
+	require.Equal(t, Version{10, 6, 12}, serverVersion(DialectMariaDB, "5.5.5-10.6.12-MariaDB"))
+	require.Equal(t, Version{11, 2}, serverVersion(DialectMariaDB, "11.2-MariaDB-log"))
+	require.Empty(t, serverVersion(DialectGeneric, "unknown"))
+
+	_, err := ParseVersion("8.0-x")
+	require.Error(t, err)
+	v, err := ParseVersion("8.0")
+	require.NoError(t, err)
+	require.Equal(t, "8.0", v.String())
+	require.Equal(t, 0, v.Compare(Version{8}))
+	require.True(t, Version{8, 0, 35}.AtLeast(8, 0))
+	require.False(t, Version{5, 7}.AtLeast(8))
+}
+
+func TestVersionRequirement(t *testing.T) {
+	for _, c := range []struct {
+		req     string
+		version Version
+		match   bool
+	}{
+		{">= 8.0", Version{8, 0, 35}, true},
+		{">= 8.0", Version{5, 7, 44}, false},
+		{"> 8.0", Version{8}, false},
+		{"<8.0", Version{5, 7}, true},
+		{"<= 5.7", Version{5, 7, 1}, false},
+		{"!= 7.5", Version{7, 5, 0}, false},
+		{"= 7.5", Version{7, 5, 0}, true},
+		{"7.5.1", Version{7, 5, 1}, true},
+	} {
+		req, err := parseVersionRequirement(c.req)
+		require.NoError(t, err, c.req)
+		require.Equal(t, c.match, req.match(c.version), "%s %s", c.req, c.version)
+	}
+	_, err := parseVersionRequirement(">= eight")
+	require.Error(t, err)
+}
+
+func TestDialectCapabilities(t *testing.T) {
+	mysql := newDialect(DialectMySQL, Version{8, 0, 35}, nil)
+	require.Equal(t, DialectMySQL, mysql.Name())
+	for _, c := range Capabilities {
+		require.True(t, mysql.Supports(c), c)
+	}
+	require.False(t, newDialect(DialectMySQL, Version{5, 6}, nil).Supports(CapSessionTrack))
+	tidb := newDialect(DialectTiDB, Version{7, 5}, nil)
+	require.True(t, tidb.Supports(CapPSProtocol))
+	require.False(t, tidb.Supports(CapGTID))
+	require.True(t, newDialect(DialectMariaDB, Version{10, 6}, nil).Supports(CapGTID))
+
+	engine := newDialect("engine", Version{1, 2}, nil)
+	require.Equal(t, "engine", engine.Name())
+	require.False(t, engine.Supports(CapPSProtocol))
+	caps, err := ParseCapabilities([]string{"ps_protocol", "GTID"})
+	require.NoError(t, err)
+	engine = newDialect("engine", Version{1, 2}, caps)
+	require.True(t, engine.Supports(CapGTID))
+	require.False(t, engine.Supports(CapResetConnection))
+	require.Equal(t, Version{1, 2}, engine.Version())
+	_, err = ParseCapabilities([]string{"xa"})
+	require.Error(t, err)
+}
+
+func TestRequireDirectives(t *testing.T) {
+	queries, err := ParseQueries([]byte("--require_dialect tidb, mysql\n--require_version >= 8.0\n--require_capability gtid\n"))
+	require.NoError(t, err)
+	require.Len(t, queries, 3)
+
+	tr := testRunner().NewTester("example")
+	tr.r.dialects[tr.opts.server()] = newDialect(DialectTiDB, Version{7, 5}, nil)
+	require.NoError(t, tr.checkRequire(queries[0]))
+	err = tr.checkRequire(queries[1])
+	reason, ok := skipReason(err)
+	require.True(t, ok)
+	require.Equal(t, "requires version >= 8.0, the server is tidb 7.5", reason)
+	require.True(t, isSkipError(tr.checkRequire(queries[2])))
+
+	tr.r.dialects[tr.opts.server()] = newDialect(DialectMariaDB, Version{10, 6}, nil)
+	require.True(t, isSkipError(tr.checkRequire(queries[0])))
+	require.NoError(t, tr.checkRequire(queries[1]))
+	require.NoError(t, tr.checkRequire(queries[2]))
+}
+
+func TestDetectDialectAtStart(t *testing.T) {
+	r := testRunner()
+	r.opts.Dir = t.TempDir()
+	r.opts.Port = "1"
+	r.opts.RetryConnCount = 1
+	p := filepath.Join(r.opts.Dir, "t", "a.test")
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte("SELECT 1;\n"), 0644))
+
+	// a server which can not be reached fails the run before any test
+	_, err := r.Run(nil)
+	require.ErrorContains(t, err, "connect to 127.0.0.1:1")
+	require.Nil(t, r.Dialect())
+
+	// a known dialect is not detected again
+	d := newDialect(DialectTiDB, Version{8, 5, 0}, nil)
+	r.dialects[r.opts.server()] = d
+	got, err := r.detectServerDialect(&r.opts)
+	require.NoError(t, err)
+	require.Equal(t, d, got)
+}
@@ -0,0 +1,397 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// ANSI escape sequences used by colored diffs.
+const (
+	colorReset   = "\x1b[0m"
+	colorRed     = "\x1b[31m"
+	colorGreen   = "\x1b[32m"
+	colorCyan    = "\x1b[36m"
+	colorReverse = "\x1b[7m"
+	colorNoRev   = "\x1b[27m"
+)
+
+// diffOptions controls the rendering of unifiedDiff.
+type diffOptions struct {
+	// fromName and toName are written in the --- and +++ header lines,
+	// which are left out if both are empty.
+	fromName string
+	toName   string
+	// fromLine and toLine are the line numbers of the first line of each
+	// side, for hunk headers of a part of a file.
+	fromLine int
+	toLine   int
+	// context is the number of unchanged lines around each change.
+	context int
+	// color highlights the changes with ANSI escape sequences, including
+	// the changed words of modified lines.
+	color bool
+}
+
+// diffOp is a line of a diff: ' ' unchanged, '-' removed or '+' added.
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// splitLines splits b into lines without their line feed.
+func splitLines(b []byte) []string {
+	if len(b) == 0 {
+		return nil
+	}
+	s := strings.TrimSuffix(string(b), "\n")
+	return strings.Split(s, "\n")
+}
+
+// maxDiffCost bounds the number of edits looked for between two parts of the
+// inputs of diffStrings: the time of Myers' algorithm grows with the square
+// of the edits. Parts needing more edits are replaced as a whole.
+const maxDiffCost = 4096
+
+// diffStrings returns an edit script turning a into b, computed with the
+// linear space variant of Myers' algorithm. It is the shortest one unless
+// the inputs differ by more than maxDiffCost edits.
+func diffStrings(a, b []string) []diffOp {
+	var d differ
+	d.diff(a, b)
+	return d.ops
+}
+
+// differ accumulates the edit script of diffStrings.
+type differ struct {
+	ops []diffOp
+}
+
+func (d *differ) add(kind byte, lines []string) {
+	for _, line := range lines {
+		d.ops = append(d.ops, diffOp{kind, line})
+	}
+}
+
+// diff appends the edit script turning a into b: it strips their common
+// prefix and suffix, then splits them on a middle point of a shortest edit
+// path and diffs both halves.
+func (d *differ) diff(a, b []string) {
+	p := 0
+	for p < len(a) && p < len(b) && a[p] == b[p] {
+		p++
+	}
+	d.add(' ', a[:p])
+	a, b = a[p:], b[p:]
+	s := 0
+	for s < len(a) && s < len(b) && a[len(a)-1-s] == b[len(b)-1-s] {
+		s++
+	}
+	suffix := a[len(a)-s:]
+	a, b = a[:len(a)-s], b[:len(b)-s]
+
+	if x, y, ok := bisect(a, b); ok {
+		d.diff(a[:x], b[:y])
+		d.diff(a[x:], b[y:])
+	} else {
+		d.add('-', a)
+		d.add('+', b)
+	}
+	d.add(' ', suffix)
+}
+
+// bisect returns a point (x, y) of a shortest edit path from a to b which
+// splits it in two shorter ones, found by running Myers' algorithm from both
+// ends until the paths overlap. a and b must not have a common prefix or
+// suffix. It fails if either is empty or the path needs more than
+// maxDiffCost edits.
+func bisect(a, b []string) (int, int, bool) {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return 0, 0, false
+	}
+	maxD := (n + m + 1) / 2
+	// vf[off+k] is the furthest x reached forward on the diagonal k, vb[off+k]
+	// the furthest one reached backward from the end, -1 if not yet reached
+	off := maxD
+	vf := make([]int, 2*maxD+2)
+	vb := make([]int, 2*maxD+2)
+	for i := range vf {
+		vf[i], vb[i] = -1, -1
+	}
+	vf[off+1], vb[off+1] = 0, 0
+	delta := n - m
+	// with an odd delta the forward paths reach the backward ones first
+	front := delta%2 != 0
+	// the diagonals at the start and the end out of the grid
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+	for d := 0; d < min(maxD, maxDiffCost); d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			vf[off+k] = x
+			if x > n {
+				fEnd += 2
+			} else if y > m {
+				fStart += 2
+			} else if front {
+				if kb := off + delta - k; kb >= 0 && kb < len(vb) && vb[kb] != -1 && x >= n-vb[kb] {
+					return x, y, true
+				}
+			}
+		}
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[n-x-1] == b[m-y-1] {
+				x++
+				y++
+			}
+			vb[off+k] = x
+			if x > n {
+				bEnd += 2
+			} else if y > m {
+				bStart += 2
+			} else if !front {
+				if kf := off + delta - k; kf >= 0 && kf < len(vf) && vf[kf] != -1 {
+					xf := vf[kf]
+					if xf >= n-x {
+						return xf, xf - (kf - off), true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// unifiedDiff renders the line diff between a and b in the unified format,
+// or returns "" if they are equal.
+func unifiedDiff(a, b []byte, opts diffOptions) string {
+	ops := diffStrings(splitLines(a), splitLines(b))
+	changed := false
+	for _, op := range ops {
+		if op.kind != ' ' {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return ""
+	}
+	var sb strings.Builder
+	if opts.fromName != "" || opts.toName != "" {
+		opts.writeLine(&sb, colorReset, "--- "+opts.fromName)
+		opts.writeLine(&sb, colorReset, "+++ "+opts.toName)
+	}
+	fromLine, toLine := max(opts.fromLine, 1), max(opts.toLine, 1)
+	// line numbers of ops[i] on each side
+	fromNo := make([]int, len(ops)+1)
+	toNo := make([]int, len(ops)+1)
+	for i, op := range ops {
+		fromNo[i], toNo[i] = fromLine, toLine
+		if op.kind != '+' {
+			fromLine++
+		}
+		if op.kind != '-' {
+			toLine++
+		}
+	}
+	fromNo[len(ops)], toNo[len(ops)] = fromLine, toLine
+
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		// a hunk spans the changes closer than 2*context lines
+		start := max(i-opts.context, 0)
+		end := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != ' ' {
+				end = j + 1
+			} else if j-end >= 2*opts.context {
+				break
+			}
+		}
+		end = min(end+opts.context, len(ops))
+		fromCnt, toCnt := 0, 0
+		for _, op := range ops[start:end] {
+			if op.kind != '+' {
+				fromCnt++
+			}
+			if op.kind != '-' {
+				toCnt++
+			}
+		}
+		opts.writeLine(&sb, colorCyan, fmt.Sprintf("@@ -%s +%s @@", hunkRange(fromNo[start], fromCnt), hunkRange(toNo[start], toCnt)))
+		opts.writeHunk(&sb, ops[start:end])
+		i = end
+	}
+	return sb.String()
+}
+
+// hunkRange formats the range of a hunk header, the start of an empty range
+// is the line before it.
+func hunkRange(start, cnt int) string {
+	if cnt == 0 {
+		start--
+	}
+	if cnt == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, cnt)
+}
+
+func (opts diffOptions) writeHunk(sb *strings.Builder, ops []diffOp) {
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			opts.writeLine(sb, colorReset, " "+ops[i].text)
+			i++
+			continue
+		}
+		j := i
+		for j < len(ops) && ops[j].kind == '-' {
+			j++
+		}
+		k := j
+		for k < len(ops) && ops[k].kind == '+' {
+			k++
+		}
+		removed, added := ops[i:j], ops[j:k]
+		pair := opts.color && len(removed) == len(added)
+		for n, op := range removed {
+			text := op.text
+			if pair {
+				text, _ = highlightWords(op.text, added[n].text)
+			}
+			opts.writeLine(sb, colorRed, "-"+text)
+		}
+		for n, op := range added {
+			text := op.text
+			if pair {
+				_, text = highlightWords(removed[n].text, op.text)
+			}
+			opts.writeLine(sb, colorGreen, "+"+text)
+		}
+		i = k
+	}
+}
+
+func (opts diffOptions) writeLine(sb *strings.Builder, color, line string) {
+	if opts.color && color != colorReset {
+		sb.WriteString(color)
+		sb.WriteString(line)
+		sb.WriteString(colorReset)
+	} else {
+		sb.WriteString(line)
+	}
+	sb.WriteString("\n")
+}
+
+// highlightWords marks the words which differ between the lines a and b
+// with reverse video.
+func highlightWords(a, b string) (string, string) {
+	ops := diffStrings(splitWords(a), splitWords(b))
+	var ra, rb strings.Builder
+	for _, op := range ops {
+		switch op.kind {
+		case ' ':
+			ra.WriteString(op.text)
+			rb.WriteString(op.text)
+		case '-':
+			ra.WriteString(colorReverse + op.text + colorNoRev)
+		case '+':
+			rb.WriteString(colorReverse + op.text + colorNoRev)
+		}
+	}
+	return ra.String(), rb.String()
+}
+
+// splitWords splits s into words and the runs of blanks between them.
+func splitWords(s string) []string {
+	var words []string
+	start := 0
+	for i := 1; i <= len(s); i++ {
+		if i == len(s) || isBlank(s[i]) != isBlank(s[i-1]) {
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+	return words
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+// diffFileName returns the file the whole diff of a failed test is written
+// to, next to its result file.
+func (t *Tester) diffFileName() string {
+	return strings.TrimSuffix(t.resultFileName(), "."+t.opts.Extension) + ".diff"
+}
+
+// writeDiffFile writes the diff between the result file and the output of
+// the test to diffFileName if the test did not match its result file, and
+// removes a stale one otherwise.
+func (t *Tester) writeDiffFile() error {
+	if t.checker == nil {
+		return nil
+	}
+	path := t.diffFileName()
+	if !t.checker.mismatched {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Trace(err)
+		}
+		return nil
+	}
+	diff := unifiedDiff(t.checker.expected, t.buf.Bytes(), diffOptions{
+		fromName: t.resultFileName(),
+		toName:   t.name + " output",
+		context:  t.opts.DiffContext,
+	})
+	return errors.Trace(writeFileAtomic(path, []byte(diff), 0644))
+}
+
+// ParseColorMode tells whether diffs are colored for the -color mode.
+func ParseColorMode(mode string) (bool, error) {
+	switch mode {
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	case "auto":
+		fi, err := os.Stdout.Stat()
+		return err == nil && fi.Mode()&os.ModeCharDevice != 0, nil
+	}
+	return false, errors.Errorf("invalid -color %q, it must be auto, always or never", mode)
+}
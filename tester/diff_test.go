@@ -0,0 +1,165 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	var a, b []string
+	for i := 1; i <= 20; i++ {
+		line := strings.Repeat("x", i)
+		a = append(a, line)
+		if i == 3 {
+			b = append(b, "changed")
+			continue
+		}
+		if i == 15 {
+			continue
+		}
+		b = append(b, line)
+		if i == 16 {
+			b = append(b, "added")
+		}
+	}
+	from := []byte(strings.Join(a, "\n") + "\n")
+	to := []byte(strings.Join(b, "\n") + "\n")
+	diff := unifiedDiff(from, to, diffOptions{fromName: "expected", toName: "got", context: 2})
+	require.Equal(t, `--- expected
++++ got
+@@ -1,5 +1,5 @@
+ x
+ xx
+-xxx
++changed
+ xxxx
+ xxxxx
+@@ -13,6 +13,6 @@
+ xxxxxxxxxxxxx
+ xxxxxxxxxxxxxx
+-xxxxxxxxxxxxxxx
+ xxxxxxxxxxxxxxxx
++added
+ xxxxxxxxxxxxxxxxx
+ xxxxxxxxxxxxxxxxxx
+`, diff)
+
+	require.Equal(t, "", unifiedDiff(from, from, diffOptions{}))
+	require.Equal(t, "@@ -10,0 +11 @@\n+b\n", unifiedDiff(nil, []byte("b\n"), diffOptions{fromLine: 11, toLine: 11}))
+}
+
+func TestHighlightWords(t *testing.T) {
+	a, b := highlightWords("1\t2020-01-01\tabc", "1\t2020-01-02\tabc")
+	require.Equal(t, "1\t"+colorReverse+"2020-01-01"+colorNoRev+"\tabc", a)
+	require.Equal(t, "1\t"+colorReverse+"2020-01-02"+colorNoRev+"\tabc", b)
+
+	diff := unifiedDiff([]byte("a b\n"), []byte("a c\n"), diffOptions{color: true})
+	require.Equal(t, colorCyan+"@@ -1 +1 @@"+colorReset+"\n"+
+		colorRed+"-a "+colorReverse+"b"+colorNoRev+colorReset+"\n"+
+		colorGreen+"+a "+colorReverse+"c"+colorNoRev+colorReset+"\n", diff)
+}
+
+// applyDiff returns both sides of the edit script ops and its edit count.
+func applyDiff(ops []diffOp) (a, b []string, edits int) {
+	for _, op := range ops {
+		if op.kind != '+' {
+			a = append(a, op.text)
+		}
+		if op.kind != '-' {
+			b = append(b, op.text)
+		}
+		if op.kind != ' ' {
+			edits++
+		}
+	}
+	return a, b, edits
+}
+
+// lcsEdits returns the number of edits of a shortest edit script from a to b.
+func lcsEdits(a, b []string) int {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return len(a) + len(b) - 2*lcs[0][0]
+}
+
+func TestDiffStrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func() []string {
+		var lines []string
+		for n := rnd.Intn(30); n > 0; n-- {
+			lines = append(lines, string(rune('a'+rnd.Intn(4))))
+		}
+		return lines
+	}
+	for i := 0; i < 1000; i++ {
+		a, b := random(), random()
+		gotA, gotB, edits := applyDiff(diffStrings(a, b))
+		require.Equal(t, a, gotA)
+		require.Equal(t, b, gotB)
+		require.Equal(t, lcsEdits(a, b), edits, "%q -> %q", a, b)
+	}
+}
+
+func TestDiffStringsLarge(t *testing.T) {
+	lines := func(n int, format string) []string {
+		l := make([]string, n)
+		for i := range l {
+			l[i] = fmt.Sprintf(format, i)
+		}
+		return l
+	}
+	// the output of a test stopping at its first mismatch, diffed against
+	// a large result file
+	expected := lines(20000, "row %d")
+	got := append(lines(99, "row %d"), "changed")
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	ops := diffStrings(expected, got)
+	runtime.ReadMemStats(&after)
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(16<<20))
+	gotA, gotB, edits := applyDiff(ops)
+	require.Equal(t, expected, gotA)
+	require.Equal(t, got, gotB)
+	require.Equal(t, 20000-99+1, edits)
+
+	// few changes in large inputs are still found exactly
+	got = append([]string(nil), expected...)
+	got[100], got[10000] = "changed", "changed"
+	got = append(got[:15000], got[15001:]...)
+	_, _, edits = applyDiff(diffStrings(expected, got))
+	require.Equal(t, 5, edits)
+
+	// inputs differing by more than maxDiffCost edits are replaced
+	got = lines(20000, "other %d")
+	_, _, edits = applyDiff(diffStrings(expected, got))
+	require.Equal(t, 40000, edits)
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/defined2014/mysql"
+	"github.com/pingcap/errors"
+)
+
+// capturedStmt is the output of a statement of a test run in capture mode,
+// buf[start:end] of the tester.
+type capturedStmt struct {
+	line  int
+	query string
+	start int
+	end   int
+	// err is the error of the statement, if it failed without matching its
+	// --error.
+	err string
+}
+
+// captureError turns err, the unexpected error of a statement run in
+// capture mode, into its output: an error is a result to compare as any
+// other. It returns the errors which still fail the test.
+func (t *Tester) captureError(stmtErr, err error) error {
+	if err == nil {
+		return nil
+	}
+	if stmtErr == nil {
+		// the statement succeeded although an error was expected, its
+		// output is already there
+		return nil
+	}
+	if _, ok := errors.Cause(stmtErr).(*mysql.MySQLError); !ok {
+		return err
+	}
+	t.writeError(stmtErr)
+	return nil
+}
+
+// attempt runs the test once, also against the reference server in
+// differential mode.
+func (t *Tester) attempt() error {
+	if t.opts.Reference == nil {
+		return t.run()
+	}
+	return t.runDifferential()
+}
+
+// runDifferential runs the test against its server and the reference one
+// at the same time, then compares the output of each statement with the
+// one of the reference. No result file is read or written, and the errors
+// of the statements are output to compare rather than failures.
+func (t *Tester) runDifferential() error {
+	ref := t.r.captureTester(t.name, t.meta, t.opts.Reference, t.suiteCfg)
+	t.capture = true
+	var refErr error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		refErr = ref.run()
+	}()
+	err := t.run()
+	wg.Wait()
+	if isSkipError(err) {
+		return err
+	}
+	if reason, ok := skipReason(refErr); ok {
+		return &SkipError{Reason: "reference: " + reason}
+	}
+	if err != nil {
+		return err
+	}
+	if refErr != nil {
+		refErr = errors.Annotatef(refErr, "reference %s", ref.opts.server())
+		if isInfraError(refErr) {
+			return newInfraError(refErr)
+		}
+		return refErr
+	}
+	mismatches := compareCaptures(ref, t)
+	if len(mismatches) > 0 {
+		return newMismatchError(fmt.Sprintf("%d of %d statements differ from the reference %s\n", len(mismatches), len(t.stmts), ref.opts.server()), mismatches, t.opts.DiffContext, true)
+	}
+	fmt.Printf("%s: ok! %d statements match the reference %s\n", reportTestName(t.name), len(t.stmts), ref.opts.server())
+	return nil
+}
+
+// capturedOutput returns the i-th statement captured by t and its output,
+// an empty one if t did not run it.
+func (t *Tester) capturedOutput(i int) (capturedStmt, []byte) {
+	if i >= len(t.stmts) {
+		return capturedStmt{}, nil
+	}
+	s := t.stmts[i]
+	return s, t.buf.Bytes()[s.start:s.end]
+}
+
+// compareCaptures returns the statements whose output in got differs from
+// the one in ref.
+func compareCaptures(ref, got *Tester) []*mismatch {
+	var mismatches []*mismatch
+	refOut, out := ref.buf.Bytes(), got.buf.Bytes()
+	for i := 0; i < len(ref.stmts) || i < len(got.stmts); i++ {
+		r, expected := ref.capturedOutput(i)
+		g, actual := got.capturedOutput(i)
+		if bytes.Equal(expected, actual) {
+			continue
+		}
+		m := &mismatch{
+			line:     g.line,
+			query:    g.query,
+			expected: expected,
+			got:      actual,
+			expLine:  lineOf(refOut, r.start),
+			gotLine:  lineOf(out, g.start),
+		}
+		if i >= len(got.stmts) {
+			m.line, m.query = r.line, r.query
+		}
+		mismatches = append(mismatches, m)
+	}
+	return mismatches
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"testing"
+
+	"github.com/defined2014/mysql"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// captured returns a tester which captured the output of the statements.
+func captured(outputs ...string) *Tester {
+	tr := testRunner().NewTester("quickbi/interval")
+	for i, out := range outputs {
+		start := tr.buf.Len()
+		tr.buf.WriteString(out)
+		tr.stmts = append(tr.stmts, capturedStmt{line: i + 1, query: "SELECT " + out, start: start, end: tr.buf.Len()})
+	}
+	return tr
+}
+
+func TestCompareCaptures(t *testing.T) {
+	ref := captured("a\n", "b\n", "c\n")
+	require.Empty(t, compareCaptures(ref, captured("a\n", "b\n", "c\n")))
+
+	mismatches := compareCaptures(ref, captured("a\n", "x\n", "c\n"))
+	require.Len(t, mismatches, 1)
+	require.Equal(t, 2, mismatches[0].line)
+	require.Equal(t, "b\n", string(mismatches[0].expected))
+	require.Equal(t, "x\n", string(mismatches[0].got))
+	require.Equal(t, 2, mismatches[0].expLine)
+
+	mismatches = compareCaptures(ref, captured("a\n"))
+	require.Len(t, mismatches, 2)
+	require.Equal(t, 3, mismatches[1].line)
+	require.Empty(t, mismatches[1].got)
+}
+
+func TestCaptureError(t *testing.T) {
+	tr := testRunner().NewTester("quickbi/interval")
+	tr.capture = true
+	stmtErr := &mysql.MySQLError{Number: 1146, Message: "Table 'test.t' doesn't exist"}
+	require.NoError(t, tr.captureError(stmtErr, stmtErr))
+	require.Equal(t, "Error 1146: Table 'test.t' doesn't exist\n", tr.buf.String())
+	require.NoError(t, tr.captureError(nil, errors.New("Statement succeeded, expected error(s) '1146'")))
+	require.Error(t, tr.captureError(errors.New("bad connection"), errors.New("bad connection")))
+	require.NoError(t, tr.captureError(nil, nil))
+}
+
+func TestDifferentialRecord(t *testing.T) {
+	r := testRunner()
+	ref := r.opts
+	r.opts.Reference = &ref
+	r.opts.RecordMode = RecordAll
+	_, err := r.Run(nil)
+	require.ErrorContains(t, err, "can not be recorded")
+}
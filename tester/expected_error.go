@@ -0,0 +1,288 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/defined2014/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/mysql-tester/errcatalog"
+	log "github.com/sirupsen/logrus"
+)
+
+// ErrInfo describes an error code, see MysqlErrNumToInfo.
+type ErrInfo struct {
+	// Name is the preferred name of the code, ER_* over Err*.
+	Name     string
+	SQLState string
+	// Message is the message template, e.g. "Table '%-.192s.%-.192s' doesn't exist".
+	Message string
+}
+
+// expectedError is one alternative of a --error directive. An alternative
+// consists of one or more conditions separated by spaces, e.g.
+//
+//	--error ER_NO_SUCH_TABLE
+//	--error S42S02
+//	--error /doesn't exist/
+//	--error 1105 S42000 /unsupported .* syntax/
+//
+// Every condition that is set must hold for the alternative to match. The
+// alternatives themselves are separated by commas, and "0" means the
+// statement is allowed to succeed.
+type expectedError struct {
+	// spec is the alternative as written in the test file.
+	spec string
+	// success is true for "0", which accepts a succeeding statement.
+	success bool
+	// code is the expected error number, 0 means any. -1 marks a name that
+	// could not be resolved, which never matches.
+	code int
+	// codeName is the symbolic name if the code was given as one.
+	codeName string
+	// sqlState is the expected five character SQLSTATE, "" means any.
+	sqlState string
+	// pattern is matched against the error message, nil means any.
+	pattern *regexp.Regexp
+}
+
+// parseExpectedErrors parses the argument of a --error directive.
+func parseExpectedErrors(s string) ([]expectedError, error) {
+	alternatives, err := splitOutsideSlashes(s, func(c rune) bool { return c == ',' })
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]expectedError, 0, len(alternatives))
+	for _, alt := range alternatives {
+		alt = strings.TrimSpace(alt)
+		if alt == "" {
+			return nil, errors.Errorf("empty alternative in --error %s", s)
+		}
+		e, err := parseExpectedError(alt)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, e)
+	}
+	return ret, nil
+}
+
+func parseExpectedError(alt string) (expectedError, error) {
+	e := expectedError{spec: alt}
+	fields, err := splitOutsideSlashes(alt, func(c rune) bool { return c == ' ' || c == '\t' })
+	if err != nil {
+		return e, err
+	}
+	conds := fields[:0]
+	for _, c := range fields {
+		if c != "" {
+			conds = append(conds, c)
+		}
+	}
+	for _, c := range conds {
+		switch {
+		case c == "0":
+			if len(conds) > 1 {
+				return e, errors.Errorf("0 can not be combined with other conditions in --error %s", alt)
+			}
+			e.success = true
+		case c[0] == '/':
+			if len(c) < 2 || c[len(c)-1] != '/' {
+				return e, errors.Errorf("unterminated message pattern %s in --error", c)
+			}
+			if e.pattern != nil {
+				return e, errors.Errorf("more than one message pattern in --error %s", alt)
+			}
+			reg, err := regexp.Compile(c[1 : len(c)-1])
+			if err != nil {
+				return e, errors.Annotatef(err, "invalid message pattern %s in --error", c)
+			}
+			e.pattern = reg
+		case isSQLState(c):
+			if e.sqlState != "" {
+				return e, errors.Errorf("more than one SQLSTATE in --error %s", alt)
+			}
+			e.sqlState = c[1:]
+		default:
+			if e.code != 0 {
+				return e, errors.Errorf("more than one error code in --error %s", alt)
+			}
+			if n, err := strconv.Atoi(c); err == nil {
+				if n <= 0 {
+					return e, errors.Errorf("invalid error code %s in --error", c)
+				}
+				e.code = n
+				continue
+			}
+			e.codeName = c
+			if n, ok := MysqlErrNameToNum[c]; ok {
+				e.code = n
+			} else {
+				e.code = -1
+			}
+		}
+	}
+	return e, nil
+}
+
+// isSQLState reports whether s is written like S42S02, the mysqltest syntax
+// for expecting an SQLSTATE rather than an error number.
+func isSQLState(s string) bool {
+	if len(s) != 6 || s[0] != 'S' {
+		return false
+	}
+	for _, c := range s[1:] {
+		if !(c >= '0' && c <= '9' || c >= 'A' && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// splitOutsideSlashes splits s at every separator that is not inside a
+// /pattern/, honouring \/ escapes.
+func splitOutsideSlashes(s string, isSep func(rune) bool) ([]string, error) {
+	var (
+		ret       []string
+		cur       strings.Builder
+		inPattern bool
+		escaped   bool
+	)
+	for _, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\' && inPattern:
+			escaped = true
+		case c == '/':
+			inPattern = !inPattern
+		case !inPattern && isSep(c):
+			ret = append(ret, cur.String())
+			cur.Reset()
+			continue
+		}
+		cur.WriteRune(c)
+	}
+	if inPattern {
+		return nil, errors.Errorf("unterminated message pattern in --error %s", s)
+	}
+	return append(ret, cur.String()), nil
+}
+
+// unknownName reports whether the alternative names an error code that is
+// not in MysqlErrNameToNum.
+func (e expectedError) unknownName() bool {
+	return e.code < 0
+}
+
+// match reports whether err satisfies every condition of the alternative.
+// Codes and SQLSTATEs can only be checked on errors returned by the server,
+// a message pattern alone is also tried on any other error.
+func (e expectedError) match(err error) bool {
+	if e.success || e.unknownName() {
+		return false
+	}
+	me, ok := errors.Cause(err).(*mysql.MySQLError)
+	if !ok {
+		return e.code == 0 && e.sqlState == "" && e.pattern != nil && e.pattern.MatchString(err.Error())
+	}
+	if e.code != 0 && int(me.Number) != e.code {
+		return false
+	}
+	if e.sqlState != "" && string(me.SQLState[:]) != e.sqlState {
+		return false
+	}
+	if e.pattern != nil && !e.pattern.MatchString(me.Message) {
+		return false
+	}
+	return true
+}
+
+func (e expectedError) String() string {
+	if e.code > 0 && e.codeName != "" {
+		return strings.Replace(e.spec, e.codeName, fmt.Sprintf("%s(%d)", e.codeName, e.code), 1)
+	}
+	return e.spec
+}
+
+func expectedErrorsString(errs []expectedError) string {
+	specs := make([]string, 0, len(errs))
+	for _, e := range errs {
+		specs = append(specs, e.String())
+	}
+	return strings.Join(specs, ",")
+}
+
+// acceptsSuccess reports whether one of the alternatives is "0".
+func acceptsSuccess(errs []expectedError) bool {
+	for _, e := range errs {
+		if e.success {
+			return true
+		}
+	}
+	return false
+}
+
+// describeError renders err with its code name and SQLSTATE, for use in
+// expected vs. received messages.
+func describeError(err error) string {
+	me, ok := errors.Cause(err).(*mysql.MySQLError)
+	if !ok {
+		return err.Error()
+	}
+	code := strconv.Itoa(int(me.Number))
+	if name := errorCodeName(int(me.Number)); name != "" {
+		code = fmt.Sprintf("%s(%d)", name, me.Number)
+	}
+	if me.SQLState != [5]byte{} {
+		return fmt.Sprintf("%s SQLSTATE %s: %s", code, me.SQLState[:], me.Message)
+	}
+	return fmt.Sprintf("%s: %s", code, me.Message)
+}
+
+// errorCodeName returns the preferred name of code, or "".
+func errorCodeName(code int) string {
+	return MysqlErrNumToInfo[code].Name
+}
+
+// LoadErrorCatalogs adds the error names of the given catalog files to
+// MysqlErrNameToNum, and new codes to MysqlErrNumToInfo. A name which is
+// already known keeps its code.
+func LoadErrorCatalogs(paths []string) error {
+	for _, path := range paths {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		entries, err := errcatalog.Load(path)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		known := len(MysqlErrNameToNum)
+		for _, c := range errcatalog.Merge(MysqlErrNameToNum, entries, path) {
+			log.Warn(c.String())
+		}
+		for _, e := range entries {
+			if _, ok := MysqlErrNumToInfo[e.Code]; !ok && MysqlErrNameToNum[e.Name] == e.Code {
+				MysqlErrNumToInfo[e.Code] = ErrInfo{Name: e.Name, SQLState: e.SQLState, Message: e.Message}
+			}
+		}
+		log.Infof("Got %d new error names from %s", len(MysqlErrNameToNum)-known, path)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/defined2014/mysql"
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func newMySQLError(code uint16, state, msg string) error {
+	me := &mysql.MySQLError{Number: code, Message: msg}
+	copy(me.SQLState[:], state)
+	return errors.Trace(me)
+}
+
+func TestParseExpectedErrors(t *testing.T) {
+	errs, err := parseExpectedErrors("ER_NO_SUCH_TABLE , 0")
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, 1146, errs[0].code)
+	require.True(t, errs[1].success)
+	require.True(t, acceptsSuccess(errs))
+
+	errs, err = parseExpectedErrors("1105 S42000 /a, b \\/ c/,S42S02")
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+	require.Equal(t, 1105, errs[0].code)
+	require.Equal(t, "42000", errs[0].sqlState)
+	require.Equal(t, "a, b \\/ c", errs[0].pattern.String())
+	require.Equal(t, "42S02", errs[1].sqlState)
+
+	errs, err = parseExpectedErrors("NON_EXISTING_ERROR")
+	require.NoError(t, err)
+	require.True(t, errs[0].unknownName())
+
+	for _, spec := range []string{"/abc", "1054,", "0 1054", "1054 1146", "/a/ /b/", "S42S02 S42000", "/[/"} {
+		_, err = parseExpectedErrors(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestExpectedErrorMatch(t *testing.T) {
+	noSuchTable := newMySQLError(1146, "42S02", "Table 'test.t' doesn't exist")
+	testCases := []struct {
+		spec  string
+		err   error
+		match bool
+	}{
+		{"ER_NO_SUCH_TABLE", noSuchTable, true},
+		{"1146", noSuchTable, true},
+		{"1054", noSuchTable, false},
+		{"S42S02", noSuchTable, true},
+		{"S42000", noSuchTable, false},
+		{"/doesn't exist$/", noSuchTable, true},
+		{"/^Error 1146/", noSuchTable, false},
+		{"1146 S42S02 /test\\.t/", noSuchTable, true},
+		{"1146 S42S02 /test\\.t2/", noSuchTable, false},
+		{"0", noSuchTable, false},
+		{"NON_EXISTING_ERROR", noSuchTable, false},
+		{"/bad connection/", errors.New("invalid connection: bad connection"), true},
+		{"1146", errors.New("invalid connection: bad connection"), false},
+	}
+	for _, testCase := range testCases {
+		errs, err := parseExpectedErrors(testCase.spec)
+		require.NoError(t, err)
+		require.Equal(t, testCase.match, errs[0].match(testCase.err), testCase.spec)
+	}
+}
+
+func TestDescribeError(t *testing.T) {
+	err := newMySQLError(1146, "42S02", "Table 'test.t' doesn't exist")
+	require.Equal(t, "ER_NO_SUCH_TABLE(1146) SQLSTATE 42S02: Table 'test.t' doesn't exist", describeError(err))
+	require.Equal(t, "oops", describeError(errors.New("oops")))
+}
+
+func TestErrorReverseIndex(t *testing.T) {
+	for name, code := range MysqlErrNameToNum {
+		info, ok := MysqlErrNumToInfo[code]
+		require.True(t, ok, name)
+		require.Equal(t, code, MysqlErrNameToNum[info.Name], name)
+	}
+	require.Equal(t, "ER_NO_SUCH_TABLE", errorCodeName(1146))
+	require.Equal(t, "ErrHashchk", errorCodeName(1000))
+	require.Equal(t, "", errorCodeName(99999))
+
+	var buf bytes.Buffer
+	require.NoError(t, PrintErrorInfo(&buf, "ErrNoSuchTable"))
+	require.Equal(t, "1146 (ER_NO_SUCH_TABLE) SQLSTATE 42S02: Table '%-.192s.%-.192s' doesn't exist\n\taliases: ErrNoSuchTable\n", buf.String())
+	require.Error(t, PrintErrorInfo(&buf, "NON_EXISTING_ERROR"))
+	require.Error(t, PrintErrorInfo(&buf, "99999"))
+}
+
+func TestErrorInfo(t *testing.T) {
+	testCases := []struct {
+		code     int
+		sqlState string
+		message  string
+	}{
+		{1062, "23000", "Duplicate entry '%-.64s' for key '%-.192s'"},
+		{1146, "42S02", "Table '%-.192s.%-.192s' doesn't exist"},
+		{3105, "HY000", "The value specified for generated column '%s' in table '%s' is not allowed."},
+		{8001, "HY000", "%s holds %dB memory, exceeds threshold %dB.%s"},
+		{9001, "HY000", "PD server timeout: %s"},
+	}
+	for _, testCase := range testCases {
+		info, ok := MysqlErrNumToInfo[testCase.code]
+		require.True(t, ok, testCase.code)
+		require.Equal(t, testCase.sqlState, info.SQLState, testCase.code)
+		require.Equal(t, testCase.message, info.Message, testCase.code)
+	}
+}
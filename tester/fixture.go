@@ -0,0 +1,308 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// fixtureSchemaPrefix prefixes the template schemas of the suite fixtures.
+const fixtureSchemaPrefix = "mysql_tester_fixture"
+
+// suiteFixture is the fixture of a suite file, set up once for all the
+// tests of the suite in a template schema:
+//
+//	fixture:
+//	  setup: [inter2.sql]
+//	  teardown: [cleanup.sql]
+//	  shared: false
+//
+// Each test gets a copy of the tables of the template schema in its own
+// schema. With shared it gets a view on each of them instead, which is
+// cheaper but the tests must not write to them. The teardown files run in
+// the template schema once the last scheduled test of the suite finished,
+// before it is dropped. Each server the tests run against has its own
+// template schema, and so has each session set up differently on it, e.g.
+// by the init SQL of a -matrix target.
+type suiteFixture struct {
+	Setup    []string `yaml:"setup"`
+	Teardown []string `yaml:"teardown"`
+	Shared   bool     `yaml:"shared"`
+
+	mu sync.Mutex
+	// states are the template schemas by fixtureKey.
+	states map[string]*fixtureState
+}
+
+// fixtureState is the template schema of a fixture on a server, with the
+// options its setup ran with.
+type fixtureState struct {
+	// mu serializes the setup, done is set once it succeeded. A failed
+	// setup runs again for the next test of the suite.
+	mu   sync.Mutex
+	done bool
+	err  error
+	// prepared is set once the template schema is registered, it has to
+	// be dropped at the end of the run.
+	prepared bool
+	schema   string
+	tables   []fixtureTable
+	// opts are the options of the test setting up the fixture, which are
+	// also the ones of its teardown.
+	opts Options
+}
+
+// fixtureTable is a table or a view of a template schema.
+type fixtureTable struct {
+	name string
+	view bool
+}
+
+// fixtureSchemaName returns the template schema of the fixture of the suite
+// file of dir, relative to t.
+func fixtureSchemaName(dir string) string {
+	if dir == "" {
+		return fixtureSchemaPrefix
+	}
+	return fixtureSchemaPrefix + "__" + schemaName(dir)
+}
+
+// fixtureKey identifies the template schemas of a fixture: the ones set up
+// on the same server with the same session are the same.
+func fixtureKey(opts *Options) string {
+	return strings.Join([]string{opts.server(), opts.User, opts.Params, opts.TimeZone, strings.Join(opts.InitSQL, ";")}, "\x00")
+}
+
+// prepare sets up the template schema with the connection of t, if no test
+// of the suite did it yet on its server with its options.
+func (f *suiteFixture) prepare(t *Tester, sf *suiteFile) (*fixtureState, error) {
+	f.mu.Lock()
+	if f.states == nil {
+		f.states = make(map[string]*fixtureState)
+	}
+	key := fixtureKey(t.opts)
+	s, ok := f.states[key]
+	if !ok {
+		s = &fixtureState{schema: fixtureSchemaName(sf.suite), opts: *t.opts}
+		// the other options on the same server get their own schema
+		n := 0
+		for _, other := range f.states {
+			if other.opts.server() == t.opts.server() {
+				n++
+			}
+		}
+		if n > 0 {
+			s.schema = fmt.Sprintf("%s_%d", s.schema, n)
+		}
+		f.states[key] = s
+	}
+	f.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.done {
+		s.err = f.setup(t, sf, s)
+		s.done = s.err == nil
+	}
+	return s, s.err
+}
+
+// setup runs the setup files of the fixture in its template schema on the
+// connection of t. Its statements are limited by the statement timeout
+// only: the deadline of t is not the one of the other tests of the suite.
+func (f *suiteFixture) setup(t *Tester, sf *suiteFile, s *fixtureState) (err error) {
+	// the schema is dropped by the teardown only, not by the tests which
+	// did not see it created
+	if !s.prepared {
+		if err = acquireSchema(s.opts.server(), s.schema); err != nil {
+			return err
+		}
+		s.prepared = true
+	}
+	s.tables = nil
+	log.Infof("%s: set up fixture %s on %s", t.name, s.schema, s.opts.server())
+	conn := t.curr
+	exec := func(stmt string) error {
+		ctx, cancel := runStmtContext(&s.opts)
+		defer cancel()
+		_, err := conn.conn.ExecContext(ctx, stmt)
+		if err != nil && ctx.Err() != nil {
+			t.killQuery(conn)
+			return &timeoutError{scope: "statement", timeout: s.opts.StmtTimeout}
+		}
+		return err
+	}
+	for _, stmt := range []string{
+		fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", s.schema),
+		fmt.Sprintf("CREATE DATABASE `%s`", s.schema),
+		fmt.Sprintf("USE `%s`", s.schema),
+	} {
+		if err = exec(stmt); err != nil {
+			return errors.Annotatef(err, "Executing %s", stmt)
+		}
+	}
+	defer func() {
+		if useErr := exec(fmt.Sprintf("USE `%s`", t.dbName)); err == nil {
+			err = errors.Trace(useErr)
+		}
+	}()
+	for _, file := range f.Setup {
+		if err = runScript(filepath.Join(sf.dir, file), exec); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := runStmtContext(&s.opts)
+	defer cancel()
+	rows, err := conn.conn.QueryContext(ctx, fmt.Sprintf("SHOW FULL TABLES FROM `%s`", s.schema))
+	if err != nil {
+		return errors.Annotate(err, "list fixture tables")
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, tp string
+		if err = rows.Scan(&name, &tp); err != nil {
+			return errors.Trace(err)
+		}
+		s.tables = append(s.tables, fixtureTable{name: name, view: tp == "VIEW"})
+	}
+	return errors.Trace(rows.Err())
+}
+
+// copyInto creates the tables of the template schema in the schema of t,
+// or views on them for a shared fixture. The views of the template schema
+// are views in both cases.
+func (f *suiteFixture) copyInto(t *Tester, s *fixtureState) error {
+	for _, table := range s.tables {
+		src := fmt.Sprintf("`%s`.`%s`", s.schema, table.name)
+		dst := fmt.Sprintf("`%s`.`%s`", t.dbName, table.name)
+		stmts := []string{fmt.Sprintf("CREATE VIEW %s AS SELECT * FROM %s", dst, src)}
+		if !f.Shared && !table.view {
+			stmts = []string{
+				fmt.Sprintf("CREATE TABLE %s LIKE %s", dst, src),
+				fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", dst, src),
+			}
+		}
+		for _, stmt := range stmts {
+			if err := t.execScriptStmt(t.curr, stmt); err != nil {
+				return errors.Annotatef(err, "Executing %s", stmt)
+			}
+		}
+	}
+	return nil
+}
+
+// teardown tears the fixture down on every server it was set up on.
+func (f *suiteFixture) teardown(sf *suiteFile) {
+	f.mu.Lock()
+	states := f.states
+	// a later test of the suite sets it up again
+	f.states = nil
+	f.mu.Unlock()
+	for _, s := range states {
+		if s.prepared {
+			f.teardownState(sf, s)
+		}
+	}
+}
+
+// teardownState runs the teardown files of the fixture, if its last setup
+// succeeded, and drops its template schema. Its errors are only logged.
+func (f *suiteFixture) teardownState(sf *suiteFile, s *fixtureState) {
+	defer releaseSchema(s.opts.server(), s.schema)
+	log.Infof("tear down fixture %s on %s", s.schema, s.opts.server())
+	exec, closeConn, err := sideConn(&s.opts)
+	if err != nil {
+		log.Warnf("fixture %s: teardown err %v", s.schema, err)
+		return
+	}
+	defer closeConn()
+	if s.err == nil {
+		if err = exec(fmt.Sprintf("USE `%s`", s.schema)); err != nil {
+			log.Warnf("fixture %s: teardown err %v", s.schema, err)
+			return
+		}
+		for _, file := range f.Teardown {
+			if err = runScript(filepath.Join(sf.dir, file), exec); err != nil {
+				log.Warnf("fixture %s: teardown err %v", s.schema, err)
+			}
+		}
+	}
+	if !s.opts.ReserveSchema {
+		if err = exec(fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", s.schema)); err != nil {
+			log.Warnf("fixture %s: drop err %v", s.schema, err)
+		}
+	}
+}
+
+// scheduleFixtures counts the tests of nodes under each directory before
+// they run, see testDone.
+func (r *Runner) scheduleFixtures(nodes []*testNode) {
+	r.suitesLock.Lock()
+	defer r.suitesLock.Unlock()
+	r.pendingTests = make(map[string]int)
+	for _, n := range nodes {
+		for _, dir := range suiteDirs(n.name) {
+			r.pendingTests[dir]++
+		}
+	}
+}
+
+// testDone tears down the fixtures of the suites whose last scheduled test
+// is name, the inner suites first. The tests run on their own by RunTest are
+// not scheduled, their fixtures are torn down by Cleanup.
+func (r *Runner) testDone(name string) {
+	var files []*suiteFile
+	r.suitesLock.Lock()
+	dirs := suiteDirs(name)
+	for i := len(dirs) - 1; i >= 0; i-- {
+		n, ok := r.pendingTests[dirs[i]]
+		if !ok {
+			continue
+		}
+		r.pendingTests[dirs[i]] = n - 1
+		if sf := r.suiteFiles[dirs[i]]; n == 1 && sf != nil && sf.Fixture != nil {
+			files = append(files, sf)
+		}
+	}
+	r.suitesLock.Unlock()
+	for _, sf := range files {
+		sf.Fixture.teardown(sf)
+	}
+}
+
+// teardownFixtures tears down the suite fixtures set up by the tests run so
+// far, the ones of the inner suites first.
+func (r *Runner) teardownFixtures() {
+	r.suitesLock.Lock()
+	var files []*suiteFile
+	for _, sf := range r.suiteFiles {
+		if sf != nil && sf.Fixture != nil {
+			files = append(files, sf)
+		}
+	}
+	r.suitesLock.Unlock()
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].suite > files[j].suite
+	})
+	for _, sf := range files {
+		sf.Fixture.teardown(sf)
+	}
+}
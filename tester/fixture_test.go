@@ -0,0 +1,235 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingDriver is a database/sql connector recording the statements run
+// on its connections. Queries list its tables, as SHOW FULL TABLES does.
+type recordingDriver struct {
+	mu     sync.Mutex
+	stmts  []string
+	tables [][]driver.Value
+	// fail is a statement failing once.
+	fail string
+}
+
+func (d *recordingDriver) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{d}, nil
+}
+
+func (d *recordingDriver) Driver() driver.Driver {
+	return nil
+}
+
+func (d *recordingDriver) record(stmt string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stmts = append(d.stmts, stmt)
+	if stmt == d.fail {
+		d.fail = ""
+		return errors.New("failed")
+	}
+	return nil
+}
+
+// take returns the statements recorded since the last call.
+func (d *recordingDriver) take() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	stmts := d.stmts
+	d.stmts = nil
+	return stmts
+}
+
+type recordingConn struct {
+	d *recordingDriver
+}
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *recordingConn) Close() error {
+	return nil
+}
+
+func (c *recordingConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (c *recordingConn) ExecContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := c.d.record(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *recordingConn) QueryContext(ctx context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := c.d.record(query); err != nil {
+		return nil, err
+	}
+	return &recordedRows{rows: c.d.tables}, nil
+}
+
+type recordedRows struct {
+	rows [][]driver.Value
+}
+
+func (r *recordedRows) Columns() []string {
+	cols := []string{"Tables_in_fixture", "Table_type"}
+	if len(r.rows) > 0 {
+		return cols[:len(r.rows[0])]
+	}
+	return cols
+}
+
+func (r *recordedRows) Close() error {
+	return nil
+}
+
+func (r *recordedRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+// fixtureRunner returns a runner of the quickbi suite, whose fixture sets
+// t1 up, and the testers of its tests on the connections of d.
+func fixtureRunner(t *testing.T, d *recordingDriver) (*Runner, func(name string) *Tester) {
+	r := testRunner()
+	r.opts.Dir = t.TempDir()
+	// the teardown connects to no server
+	r.opts.Port = "1"
+	r.opts.RetryConnCount = 1
+	write := func(name, content string) {
+		p := filepath.Join(r.opts.Dir, "t", filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+	write("quickbi/suite.yaml", "fixture:\n  setup: [inter2.sql]\n")
+	write("quickbi/inter2.sql", "create table t1 (a int);\ninsert into t1 values (1);\n")
+
+	db := sql.OpenDB(d)
+	t.Cleanup(func() { db.Close() })
+	return r, func(name string) *Tester {
+		tr := r.NewTester(name)
+		require.NoError(t, tr.applySuite())
+		conn, err := db.Conn(context.Background())
+		require.NoError(t, err)
+		tr.curr = &Conn{mdb: db, conn: conn}
+		return tr
+	}
+}
+
+func TestFixtureSetupAndCopy(t *testing.T) {
+	d := &recordingDriver{tables: [][]driver.Value{{"t1", "BASE TABLE"}, {"v1", "VIEW"}}}
+	r, newTester := fixtureRunner(t, d)
+	a, b := r.testSchemaName("quickbi/a"), r.testSchemaName("quickbi/b")
+	r.scheduleFixtures([]*testNode{{name: "quickbi/a"}, {name: "quickbi/b"}, {name: "example"}})
+
+	// the first test of the suite sets the template schema up
+	require.NoError(t, newTester("quickbi/a").setup())
+	require.Equal(t, []string{
+		"DROP DATABASE IF EXISTS `mysql_tester_fixture__quickbi`",
+		"CREATE DATABASE `mysql_tester_fixture__quickbi`",
+		"USE `mysql_tester_fixture__quickbi`",
+		"create table t1 (a int)",
+		"insert into t1 values (1)",
+		"SHOW FULL TABLES FROM `mysql_tester_fixture__quickbi`",
+		"USE `" + a + "`",
+		"CREATE TABLE `" + a + "`.`t1` LIKE `mysql_tester_fixture__quickbi`.`t1`",
+		"INSERT INTO `" + a + "`.`t1` SELECT * FROM `mysql_tester_fixture__quickbi`.`t1`",
+		"CREATE VIEW `" + a + "`.`v1` AS SELECT * FROM `mysql_tester_fixture__quickbi`.`v1`",
+	}, d.take())
+	server := r.opts.server()
+	require.True(t, isActiveSchema(server, "mysql_tester_fixture__quickbi"))
+
+	// the next ones only copy it
+	require.NoError(t, newTester("quickbi/b").setup())
+	require.Equal(t, []string{
+		"CREATE TABLE `" + b + "`.`t1` LIKE `mysql_tester_fixture__quickbi`.`t1`",
+		"INSERT INTO `" + b + "`.`t1` SELECT * FROM `mysql_tester_fixture__quickbi`.`t1`",
+		"CREATE VIEW `" + b + "`.`v1` AS SELECT * FROM `mysql_tester_fixture__quickbi`.`v1`",
+	}, d.take())
+
+	// the fixture is torn down once the last test of the suite is done,
+	// before the end of the run
+	sc, err := r.suiteOf("quickbi/a")
+	require.NoError(t, err)
+	f := sc.files[0].Fixture
+	r.testDone("quickbi/a")
+	require.Len(t, f.states, 1)
+	r.testDone("quickbi/b")
+	require.Empty(t, f.states)
+	require.False(t, isActiveSchema(server, "mysql_tester_fixture__quickbi"))
+}
+
+func TestFixtureRetryAndOptions(t *testing.T) {
+	d := &recordingDriver{tables: [][]driver.Value{{"t1", "BASE TABLE"}}, fail: "insert into t1 values (1)"}
+	r, newTester := fixtureRunner(t, d)
+	r.scheduleFixtures([]*testNode{{name: "quickbi/a"}, {name: "quickbi/b"}, {name: "quickbi/c"}})
+
+	// a failed setup runs again for the next test, whose deadline only
+	// limits its own copy of the template schema
+	require.Error(t, newTester("quickbi/a").setup())
+	d.take()
+	tr := newTester("quickbi/b")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tr.ctx = ctx
+	err := tr.setup()
+	require.True(t, isTimeout(err))
+	require.ErrorContains(t, err, "copy suite fixture")
+	stmts := d.take()
+	require.Equal(t, "DROP DATABASE IF EXISTS `mysql_tester_fixture__quickbi`", stmts[0])
+	require.Contains(t, stmts, "insert into t1 values (1)")
+	require.NoError(t, newTester("quickbi/b").setup())
+	require.NotContains(t, d.take(), "insert into t1 values (1)")
+
+	// another session on the same server gets its own template schema
+	tr = newTester("quickbi/c")
+	tr.opts.InitSQL = []string{"SET @@tidb_max_chunk_size = 32"}
+	require.NoError(t, tr.setup())
+	require.Equal(t, "DROP DATABASE IF EXISTS `mysql_tester_fixture__quickbi_1`", d.take()[0])
+
+	sc, err := r.suiteOf("quickbi/a")
+	require.NoError(t, err)
+	require.Len(t, sc.files[0].Fixture.states, 2)
+	for _, name := range []string{"quickbi/a", "quickbi/b", "quickbi/c"} {
+		r.testDone(name)
+	}
+	require.False(t, isActiveSchema(r.opts.server(), "mysql_tester_fixture__quickbi_1"))
+}
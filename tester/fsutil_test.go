@@ -0,0 +1,64 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "a.result")
+	require.NoError(t, writeFileAtomic(path, []byte("old\n"), 0644))
+	require.NoError(t, writeFileAtomic(path, []byte("new\n"), 0600))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "new\n", string(content))
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	require.Error(t, writeFileAtomic(filepath.Join(dir, "missing", "a.result"), nil, 0644))
+}
+
+func TestFlushReport(t *testing.T) {
+	r := testRunner()
+	r.opts.XUnitFile = filepath.Join(t.TempDir(), "report.xml")
+	t.Setenv("GOVERSION", "go-test")
+
+	r.initReport(2, time.Now())
+	durations, err := loadTestDurations(r.opts.XUnitFile)
+	require.NoError(t, err)
+	require.Empty(t, durations)
+
+	tr := r.NewTester("a")
+	now := time.Now()
+	tr.addSuccess(&now, 1)
+	r.FlushReport()
+	r.FlushReport()
+	content, err := os.ReadFile(r.opts.XUnitFile)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `tests="2"`)
+	require.Contains(t, string(content), `name="./t/a.test"`)
+	require.Equal(t, 1, strings.Count(string(content), `name="go.version"`))
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gotest runs mysql-tester tests from go test, each .test file as
+// a subtest:
+//
+//	func TestSQL(t *testing.T) {
+//		gotest.Run(t, "testdata")
+//	}
+//
+// `go test -mysql-tester.update` records the result files instead of
+// checking them, and so does -update if the test binary defines it. The
+// server is given by the MYSQL_TESTER_* environment variables, see
+// OptionsFromEnv.
+package gotest
+
+import (
+	"errors"
+	"flag"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/pingcap/mysql-tester/tester"
+)
+
+// Environment variables locating the server.
+const (
+	EnvHost     = "MYSQL_TESTER_HOST"
+	EnvPort     = "MYSQL_TESTER_PORT"
+	EnvUser     = "MYSQL_TESTER_USER"
+	EnvPassword = "MYSQL_TESTER_PASSWORD"
+	// EnvParams are additional DSN parameters, e.g.
+	// tidb_cost_model_version=2&sql_mode=%27%27.
+	EnvParams = "MYSQL_TESTER_PARAMS"
+	// EnvRetryConnCount is the max number of attempts to connect to the
+	// server, a test fails quicker on a missing server with a lower one.
+	EnvRetryConnCount = "MYSQL_TESTER_RETRY_CONNECTION_COUNT"
+)
+
+// update is namespaced, since the tests of a package often define their
+// own -update for their golden files.
+var update = flag.Bool("mysql-tester.update", false, "record the result files of the mysql-tester tests instead of checking them")
+
+// updating reports whether the result files are recorded: with
+// -mysql-tester.update, or with the -update flag of the test binary if it
+// has one.
+func updating() bool {
+	if *update {
+		return true
+	}
+	if f := flag.Lookup("update"); f != nil {
+		if g, ok := f.Value.(flag.Getter); ok {
+			b, _ := g.Get().(bool)
+			return b
+		}
+	}
+	return false
+}
+
+// OptionsFromEnv returns the default options for the tests of dir, the
+// directory holding their t and r directories, with the server given by
+// the MYSQL_TESTER_* environment variables, and in record mode with
+// -mysql-tester.update or -update, see updating.
+func OptionsFromEnv(dir string) (tester.Options, error) {
+	opts := tester.DefaultOptions()
+	opts.Dir = dir
+	if v := os.Getenv(EnvHost); v != "" {
+		opts.Host = v
+	}
+	if v := os.Getenv(EnvPort); v != "" {
+		opts.Port = v
+	}
+	if v := os.Getenv(EnvUser); v != "" {
+		opts.User = v
+	}
+	opts.Password = os.Getenv(EnvPassword)
+	opts.Params = os.Getenv(EnvParams)
+	if v := os.Getenv(EnvRetryConnCount); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return opts, err
+		}
+		opts.RetryConnCount = n
+	}
+	if updating() {
+		opts.RecordMode = tester.RecordAll
+	}
+	return opts, nil
+}
+
+// Run runs every test found under the t directory of dir as a subtest
+// named after it, e.g. TestSQL/quickbi/interval, with the options of
+// OptionsFromEnv.
+func Run(t *testing.T, dir string) {
+	t.Helper()
+	opts, err := OptionsFromEnv(dir)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", EnvRetryConnCount, err)
+	}
+	RunWithOptions(t, opts)
+}
+
+// RunWithOptions runs every test found under the t directory of opts.Dir
+// as a subtest. A failed test reports its error, with the diff of its
+// result, through t.Errorf.
+func RunWithOptions(t *testing.T, opts tester.Options) {
+	t.Helper()
+	tests, err := opts.LoadAllTests()
+	if err != nil {
+		t.Fatalf("load tests: %v", err)
+	}
+	if len(tests) == 0 {
+		t.Fatalf("no test found in %s", opts.Dir)
+	}
+	r := tester.NewRunner(opts)
+	defer r.Cleanup()
+	for _, name := range tests {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			err := r.RunTest(name)
+			var skip *tester.SkipError
+			if errors.As(err, &skip) {
+				t.Skip(skip.Reason)
+			}
+			if err != nil {
+				t.Errorf("%v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gotest
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/pingcap/mysql-tester/tester"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionsFromEnv(t *testing.T) {
+	t.Setenv(EnvHost, "db")
+	t.Setenv(EnvPort, "4000")
+	t.Setenv(EnvUser, "")
+	t.Setenv(EnvPassword, "secret")
+	t.Setenv(EnvParams, "tidb_cost_model_version=2")
+	t.Setenv(EnvRetryConnCount, "3")
+	opts, err := OptionsFromEnv("testdata")
+	require.NoError(t, err)
+	require.Equal(t, "testdata", opts.Dir)
+	require.Equal(t, "db", opts.Host)
+	require.Equal(t, "4000", opts.Port)
+	require.Equal(t, "root", opts.User)
+	require.Equal(t, "secret", opts.Password)
+	require.Equal(t, "tidb_cost_model_version=2", opts.Params)
+	require.Equal(t, 3, opts.RetryConnCount)
+	require.Equal(t, tester.RecordNone, opts.RecordMode)
+
+	*update = true
+	opts, err = OptionsFromEnv("testdata")
+	*update = false
+	require.NoError(t, err)
+	require.Equal(t, tester.RecordAll, opts.RecordMode)
+
+	// the -update of the test binary, if any, records too
+	if flag.Lookup("update") == nil {
+		flag.Bool("update", false, "")
+	}
+	require.NoError(t, flag.Set("update", "true"))
+	defer flag.Set("update", "false")
+	opts, err = OptionsFromEnv("testdata")
+	require.NoError(t, err)
+	require.Equal(t, tester.RecordAll, opts.RecordMode)
+
+	t.Setenv(EnvRetryConnCount, "many")
+	_, err = OptionsFromEnv("testdata")
+	require.Error(t, err)
+}
@@ -0,0 +1,28 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import "github.com/pingcap/errors"
+
+// errInterrupted stops a test when the run is interrupted.
+var errInterrupted = errors.New("run interrupted")
+
+// checkInterrupted returns an error once the run of the test was
+// interrupted.
+func (t *Tester) checkInterrupted() error {
+	if t.r.Interrupted() {
+		return newInfraError(errInterrupted)
+	}
+	return nil
+}
@@ -0,0 +1,381 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// MatrixResult is the outcome of a test or a statement on a target of a
+// compatibility matrix, compared with the reference.
+type MatrixResult string
+
+// Outcomes of the compatibility matrix. A test has the worst outcome of its
+// statements, or error if it could not run.
+const (
+	MatrixMatch   MatrixResult = "match"
+	MatrixDiffers MatrixResult = "differs"
+	MatrixError   MatrixResult = "error"
+	MatrixSkipped MatrixResult = "skipped"
+)
+
+// severity orders the outcomes, from the best to the worst.
+func (m MatrixResult) severity() int {
+	switch m {
+	case MatrixMatch:
+		return 0
+	case MatrixSkipped:
+		return 1
+	case MatrixDiffers:
+		return 2
+	}
+	return 3
+}
+
+// MatrixTarget is a server of a compatibility matrix.
+type MatrixTarget struct {
+	Name    string
+	Options Options
+}
+
+// Matrix is the compatibility matrix of tests run against several targets:
+// the outcome of each test and of each of its statements on each target,
+// compared with the output of the reference.
+type Matrix struct {
+	Reference MatrixServer   `json:"reference"`
+	Targets   []MatrixServer `json:"targets"`
+	Tests     []*MatrixTest  `json:"tests"`
+}
+
+// MatrixServer describes a target, with the dialect and the version of its
+// server if a test could connect to it.
+type MatrixServer struct {
+	Name    string `json:"name"`
+	Server  string `json:"server"`
+	Dialect string `json:"dialect,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+// MatrixTest is a row of the matrix.
+type MatrixTest struct {
+	Name string `json:"name"`
+	// Results are the outcomes of the test by target.
+	Results map[string]MatrixResult `json:"results"`
+	// Errors are the errors of the test by target, when it could not run,
+	// and the reasons it was skipped.
+	Errors     map[string]string  `json:"errors,omitempty"`
+	Statements []*MatrixStatement `json:"statements,omitempty"`
+}
+
+// MatrixStatement is a statement of a test of the matrix.
+type MatrixStatement struct {
+	Line    int                     `json:"line"`
+	Query   string                  `json:"query"`
+	Results map[string]MatrixResult `json:"results"`
+}
+
+// RunMatrix runs the tests selected by args against the server of the runner,
+// the reference, and against the targets at the same time, and compares the
+// output of each statement on a target with the one of the reference. Like
+// differential runs, it reads and writes no result file.
+func (r *Runner) RunMatrix(args []string, targets []MatrixTarget) (*Matrix, error) {
+	if len(targets) == 0 {
+		return nil, errors.New("no target to compare with the reference")
+	}
+	tests, metas, skippedTests, invalidTests, err := r.selectTests(args)
+	if err != nil {
+		return nil, err
+	}
+	// the reference has to be reached, the targets which can not be are
+	// reported by their tests
+	if len(tests) > 0 {
+		if _, err = r.detectServerDialect(&r.opts); err != nil {
+			return nil, err
+		}
+		for i := range targets {
+			if _, err := r.detectServerDialect(&targets[i].Options); err != nil {
+				log.Warnf("matrix target %s: %v", targets[i].Name, err)
+			}
+		}
+	}
+	m := &Matrix{Reference: MatrixServer{Name: r.opts.Target, Server: r.opts.server()}}
+	if m.Reference.Name == "" {
+		m.Reference.Name = r.opts.server()
+	}
+	for _, target := range targets {
+		m.Targets = append(m.Targets, MatrixServer{Name: target.Name, Server: target.Options.server()})
+	}
+	for _, task := range skippedTests {
+		m.Tests = append(m.Tests, m.notRun(task.test, MatrixSkipped, task.skip))
+	}
+	for _, task := range invalidTests {
+		m.Tests = append(m.Tests, m.notRun(task.test, MatrixError, task.err.Error()))
+	}
+
+	nodes, err := buildTestGraph(tests, metas)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var mu sync.Mutex
+	r.scheduleFixtures(nodes)
+	runTestGraph(nodes, r.opts.Parallel, func(name string) {
+		defer r.testDone(name)
+		var row *MatrixTest
+		if r.Interrupted() {
+			row = m.notRun(name, MatrixSkipped, errInterrupted.Error())
+		} else {
+			row = r.matrixTest(m, name, metas[name], targets)
+		}
+		log.Infof("matrix test [%s]: %v", name, row.Results)
+		mu.Lock()
+		m.Tests = append(m.Tests, row)
+		mu.Unlock()
+	})
+	r.Cleanup()
+
+	sort.Slice(m.Tests, func(i, j int) bool {
+		return m.Tests[i].Name < m.Tests[j].Name
+	})
+	r.dialectLock.Lock()
+	for _, s := range append([]*MatrixServer{&m.Reference}, serverRefs(m.Targets)...) {
+		if d, ok := r.dialects[s.Server]; ok {
+			s.Dialect, s.Version = d.Name(), d.Version().String()
+		}
+	}
+	r.dialectLock.Unlock()
+	return m, nil
+}
+
+func serverRefs(servers []MatrixServer) []*MatrixServer {
+	refs := make([]*MatrixServer, len(servers))
+	for i := range servers {
+		refs[i] = &servers[i]
+	}
+	return refs
+}
+
+// notRun returns the row of a test which did not run, with the same
+// outcome on every target.
+func (m *Matrix) notRun(name string, result MatrixResult, reason string) *MatrixTest {
+	row := &MatrixTest{Name: name, Results: make(map[string]MatrixResult), Errors: make(map[string]string)}
+	for _, target := range m.Targets {
+		row.Results[target.Name] = result
+		row.Errors[target.Name] = reason
+	}
+	return row
+}
+
+// captureTester returns a tester of the test name which captures its output
+// on the server of opts, with the suite sc.
+func (r *Runner) captureTester(name string, meta *testMeta, opts *Options, sc *suiteConfig) *Tester {
+	t := r.NewTester(name)
+	t.meta = meta
+	o := *opts
+	o.Reference = nil
+	t.opts = &o
+	t.setSuite(sc)
+	t.capture = true
+	return t
+}
+
+// matrixTest runs the test name against the reference and the targets and
+// returns its row.
+func (r *Runner) matrixTest(m *Matrix, name string, meta *testMeta, targets []MatrixTarget) *MatrixTest {
+	sc, err := r.suiteOf(name)
+	if err != nil {
+		return m.notRun(name, MatrixError, errors.Annotate(err, "load suite").Error())
+	}
+	testers := make([]*Tester, len(targets)+1)
+	errs := make([]error, len(testers))
+	testers[0] = r.captureTester(name, meta, &r.opts, sc)
+	for i := range targets {
+		testers[i+1] = r.captureTester(name, meta, &targets[i].Options, sc)
+	}
+	var wg sync.WaitGroup
+	for i, t := range testers {
+		wg.Add(1)
+		go func(i int, t *Tester) {
+			defer wg.Done()
+			errs[i] = t.run()
+		}(i, t)
+	}
+	wg.Wait()
+
+	if reason, ok := skipReason(errs[0]); ok {
+		return m.notRun(name, MatrixSkipped, "reference: "+reason)
+	}
+	if errs[0] != nil {
+		return m.notRun(name, MatrixError, "reference: "+errs[0].Error())
+	}
+	ref := testers[0]
+	row := &MatrixTest{Name: name, Results: make(map[string]MatrixResult), Errors: make(map[string]string)}
+	for i := range ref.stmts {
+		s := ref.stmts[i]
+		row.Statements = append(row.Statements, &MatrixStatement{Line: s.line, Query: s.query, Results: make(map[string]MatrixResult)})
+	}
+	for i, target := range targets {
+		t, err := testers[i+1], errs[i+1]
+		if reason, ok := skipReason(err); ok {
+			row.Results[target.Name] = MatrixSkipped
+			row.Errors[target.Name] = reason
+			continue
+		}
+		if err != nil {
+			row.Results[target.Name] = MatrixError
+			row.Errors[target.Name] = err.Error()
+			continue
+		}
+		result := MatrixMatch
+		for j, stmt := range row.Statements {
+			stmt.Results[target.Name] = compareCaptured(ref, t, j)
+			if stmt.Results[target.Name].severity() > result.severity() {
+				result = stmt.Results[target.Name]
+			}
+		}
+		if len(t.stmts) != len(ref.stmts) {
+			result = MatrixDiffers
+		}
+		row.Results[target.Name] = result
+	}
+	if len(row.Errors) == 0 {
+		row.Errors = nil
+	}
+	return row
+}
+
+// compareCaptured returns the outcome of the i-th statement captured by got
+// compared with the one captured by ref: error if it failed unexpectedly
+// and differs from the reference.
+func compareCaptured(ref, got *Tester, i int) MatrixResult {
+	_, expected := ref.capturedOutput(i)
+	s, actual := got.capturedOutput(i)
+	switch {
+	case bytes.Equal(expected, actual):
+		return MatrixMatch
+	case s.err != "":
+		return MatrixError
+	}
+	return MatrixDiffers
+}
+
+// WriteJSON writes the matrix as indented JSON.
+func (m *Matrix) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return errors.Trace(enc.Encode(m))
+}
+
+// WriteMarkdown writes the matrix as Markdown: the outcome of each test on
+// each target, then the outcome of each statement of the tests which do not
+// match everywhere.
+func (m *Matrix) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# Compatibility matrix\n\n")
+	fmt.Fprintf(&b, "Reference: %s\n\n", m.Reference.describe())
+	header := func(first ...string) {
+		cols := append([]string(nil), first...)
+		for _, t := range m.Targets {
+			cols = append(cols, t.describe())
+		}
+		writeMarkdownRow(&b, cols)
+		for i := range cols {
+			cols[i] = "---"
+		}
+		writeMarkdownRow(&b, cols)
+	}
+
+	header("Test")
+	for _, test := range m.Tests {
+		cols := []string{test.Name}
+		for _, t := range m.Targets {
+			cols = append(cols, string(test.Results[t.Name]))
+		}
+		writeMarkdownRow(&b, cols)
+	}
+
+	for _, test := range m.Tests {
+		if test.allMatch() {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", test.Name)
+		for _, t := range m.Targets {
+			if reason, ok := test.Errors[t.Name]; ok {
+				fmt.Fprintf(&b, "- %s: %s\n", t.Name, oneLine(reason))
+			}
+		}
+		if len(test.Statements) == 0 {
+			continue
+		}
+		if len(test.Errors) > 0 {
+			b.WriteString("\n")
+		}
+		header("Line", "Statement")
+		for _, stmt := range test.Statements {
+			cols := []string{fmt.Sprint(stmt.Line), "`" + oneLine(stmt.Query) + "`"}
+			for _, t := range m.Targets {
+				result, ok := stmt.Results[t.Name]
+				if !ok {
+					result = test.Results[t.Name]
+				}
+				cols = append(cols, string(result))
+			}
+			writeMarkdownRow(&b, cols)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return errors.Trace(err)
+}
+
+func (t *MatrixTest) allMatch() bool {
+	for _, result := range t.Results {
+		if result != MatrixMatch {
+			return false
+		}
+	}
+	return true
+}
+
+func (s MatrixServer) describe() string {
+	if s.Dialect == "" {
+		return s.Name
+	}
+	return fmt.Sprintf("%s (%s %s)", s.Name, s.Dialect, s.Version)
+}
+
+// maxMarkdownQuery is the length statements are cut to in Markdown tables.
+const maxMarkdownQuery = 80
+
+// oneLine makes s fit in a Markdown table cell.
+func oneLine(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if r := []rune(s); len(r) > maxMarkdownQuery {
+		s = string(r[:maxMarkdownQuery-3]) + "..."
+	}
+	return strings.NewReplacer("|", `\|`, "`", "'").Replace(s)
+}
+
+func writeMarkdownRow(b *strings.Builder, cols []string) {
+	b.WriteString("| ")
+	b.WriteString(strings.Join(cols, " | "))
+	b.WriteString(" |\n")
+}
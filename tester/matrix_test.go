@@ -0,0 +1,98 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareCaptured(t *testing.T) {
+	ref := captured("a\n", "b\n", "c\n")
+	got := captured("a\n", "x\n", "Error 1064: syntax error\n")
+	got.stmts[2].err = "Error 1064: syntax error"
+	require.Equal(t, MatrixMatch, compareCaptured(ref, got, 0))
+	require.Equal(t, MatrixDiffers, compareCaptured(ref, got, 1))
+	require.Equal(t, MatrixError, compareCaptured(ref, got, 2))
+	require.Equal(t, MatrixDiffers, compareCaptured(ref, captured("a\n"), 1))
+}
+
+func testMatrix() *Matrix {
+	m := &Matrix{
+		Reference: MatrixServer{Name: "mysql", Server: "127.0.0.1:3306", Dialect: "mysql", Version: "8.0.36"},
+		Targets: []MatrixServer{
+			{Name: "tidb", Server: "127.0.0.1:4000", Dialect: "tidb", Version: "8.5.0"},
+			{Name: "mariadb", Server: "127.0.0.1:3307"},
+		},
+	}
+	m.Tests = append(m.Tests, &MatrixTest{
+		Name:    "example",
+		Results: map[string]MatrixResult{"tidb": MatrixMatch, "mariadb": MatrixMatch},
+	}, &MatrixTest{
+		Name:    "quickbi/interval",
+		Results: map[string]MatrixResult{"tidb": MatrixDiffers, "mariadb": MatrixError},
+		Errors:  map[string]string{"mariadb": "dial tcp: connection refused"},
+		Statements: []*MatrixStatement{
+			{Line: 1, Query: "SELECT 1", Results: map[string]MatrixResult{"tidb": MatrixMatch}},
+			{Line: 3, Query: "SELECT a|b\nFROM t", Results: map[string]MatrixResult{"tidb": MatrixDiffers}},
+		},
+	}, m.notRun("gtid", MatrixSkipped, "reference: requires capability gtid"))
+	return m
+}
+
+func TestMatrixMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, testMatrix().WriteMarkdown(&b))
+	require.Equal(t, `# Compatibility matrix
+
+Reference: mysql (mysql 8.0.36)
+
+| Test | tidb (tidb 8.5.0) | mariadb |
+| --- | --- | --- |
+| example | match | match |
+| quickbi/interval | differs | error |
+| gtid | skipped | skipped |
+
+## quickbi/interval
+
+- mariadb: dial tcp: connection refused
+
+| Line | Statement | tidb (tidb 8.5.0) | mariadb |
+| --- | --- | --- | --- |
+| 1 | `+"`SELECT 1`"+` | match | error |
+| 3 | `+"`SELECT a\\|b FROM t`"+` | differs | error |
+
+## gtid
+
+- tidb: reference: requires capability gtid
+- mariadb: reference: requires capability gtid
+`, b.String())
+}
+
+func TestMatrixJSON(t *testing.T) {
+	var b bytes.Buffer
+	m := testMatrix()
+	require.NoError(t, m.WriteJSON(&b))
+	var got Matrix
+	require.NoError(t, json.Unmarshal(b.Bytes(), &got))
+	require.Equal(t, m, &got)
+}
+
+func TestRunMatrixNoTarget(t *testing.T) {
+	_, err := testRunner().RunMatrix(nil, nil)
+	require.ErrorContains(t, err, "no target")
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// Tags known to the scheduler.
+const (
+	// tagFirst runs the test before every test without it, e.g. for tests
+	// listing all databases.
+	tagFirst = "first"
+	// tagSerial runs the test while no other test is running.
+	tagSerial = "serial"
+	// tagSlow starts the test before the other ready tests.
+	tagSlow = "slow"
+)
+
+// testMeta is the metadata a test declares in the comment block at the top
+// of its file:
+//
+//	# @tags: slow, serial
+//	# @depends: show
+//	# @timeout: 5m
+//	# @owner: someone@example.com
+type testMeta struct {
+	tags    []string
+	depends []string
+	timeout time.Duration
+	owner   string
+}
+
+// loadTestMeta reads the metadata of the test file.
+func loadTestMeta(file string) (*testMeta, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer f.Close()
+	return parseTestMeta(f)
+}
+
+// parseTestMeta parses the "# @key: value" lines of the leading comment
+// block, which ends at the first blank or non comment line. Other comments
+// in the block are ignored.
+func parseTestMeta(r io.Reader) (*testMeta, error) {
+	meta := &testMeta{}
+	s := bufio.NewScanner(r)
+	inBlock := false
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" && !inBlock {
+			continue
+		}
+		if !strings.HasPrefix(text, "#") {
+			break
+		}
+		inBlock = true
+		text = strings.TrimSpace(strings.TrimPrefix(text, "#"))
+		if !strings.HasPrefix(text, "@") {
+			continue
+		}
+		key, value, ok := strings.Cut(text[1:], ":")
+		if !ok {
+			return nil, errors.Errorf("line %d: metadata must look like # @key: value", line)
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "tags":
+			meta.tags = append(meta.tags, SplitList(value)...)
+		case "depends":
+			meta.depends = append(meta.depends, SplitList(value)...)
+		case "timeout":
+			d, err := time.ParseDuration(value)
+			if err != nil || d <= 0 {
+				return nil, errors.Errorf("line %d: invalid @timeout %q", line, value)
+			}
+			meta.timeout = d
+		case "owner":
+			meta.owner = value
+		default:
+			return nil, errors.Errorf("line %d: unknown metadata @%s", line, strings.TrimSpace(key))
+		}
+	}
+	return meta, errors.Trace(s.Err())
+}
+
+// dependencies returns the @depends list.
+func (m *testMeta) dependencies() []string {
+	if m == nil {
+		return nil
+	}
+	return m.depends
+}
+
+// SplitList splits a comma separated list, dropping empty items, as the
+// list flags of mysql-tester and the @tags of the tests are.
+func SplitList(s string) []string {
+	var ret []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+func (m *testMeta) hasTag(tag string) bool {
+	if m == nil {
+		return false
+	}
+	for _, t := range m.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// hasAnyTag reports whether the test has one of tags.
+func (m *testMeta) hasAnyTag(tags []string) bool {
+	for _, tag := range tags {
+		if m.hasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTestsByTags keeps the tests having one of include, if any is
+// given, and none of exclude.
+func filterTestsByTags(tests []string, metas map[string]*testMeta, include, exclude []string) []string {
+	ret := make([]string, 0, len(tests))
+	for _, name := range tests {
+		meta := metas[name]
+		if len(include) > 0 && !meta.hasAnyTag(include) {
+			continue
+		}
+		if meta.hasAnyTag(exclude) {
+			continue
+		}
+		ret = append(ret, name)
+	}
+	return ret
+}
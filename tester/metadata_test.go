@@ -0,0 +1,68 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTestMeta(t *testing.T) {
+	input := `# Checks DATE_FORMAT of all quickbi types.
+# @tags: slow, serial
+# @depends: show
+#@depends: infoschema
+# @timeout: 5m
+# @owner: bi-team
+
+# @tags: ignored, after the header
+select 1;
+`
+	meta, err := parseTestMeta(strings.NewReader(input))
+	require.NoError(t, err)
+	require.Equal(t, &testMeta{
+		tags:    []string{"slow", "serial"},
+		depends: []string{"show", "infoschema"},
+		timeout: 5 * time.Minute,
+		owner:   "bi-team",
+	}, meta)
+	require.True(t, meta.hasTag(tagSerial))
+	require.False(t, meta.hasTag(tagFirst))
+
+	meta, err = parseTestMeta(strings.NewReader("select 1;\n"))
+	require.NoError(t, err)
+	require.Equal(t, &testMeta{}, meta)
+
+	for _, input := range []string{"# @timeout: soon", "# @timeout: -1s", "# @color: red", "# @tags"} {
+		_, err = parseTestMeta(strings.NewReader(input))
+		require.Error(t, err, input)
+	}
+}
+
+func TestFilterTestsByTags(t *testing.T) {
+	tests := []string{"a", "b", "c", "d"}
+	metas := map[string]*testMeta{
+		"a": {tags: []string{"slow"}},
+		"b": {tags: []string{"slow", "flaky"}},
+		"c": {tags: []string{"quick"}},
+		"d": {},
+	}
+	require.Equal(t, tests, filterTestsByTags(tests, metas, nil, nil))
+	require.Equal(t, []string{"a", "b"}, filterTestsByTags(tests, metas, []string{"slow"}, nil))
+	require.Equal(t, []string{"a"}, filterTestsByTags(tests, metas, []string{"slow"}, []string{"flaky"}))
+	require.Equal(t, []string{"a", "c", "d"}, filterTestsByTags(tests, metas, nil, []string{"flaky"}))
+}
@@ -0,0 +1,250 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+)
+
+// mismatch is a statement whose output differs from the result file.
+type mismatch struct {
+	line  int
+	query string
+	// expected and got are the output of the statement, and of the following
+	// ones until the expected result could be synchronized again.
+	expected []byte
+	got      []byte
+	// expLine and gotLine are the line numbers of the start of expected in
+	// the result file and of got in the output.
+	expLine int
+	gotLine int
+}
+
+// diff renders the unified diff of the mismatch with context unchanged
+// lines around the changes.
+func (m *mismatch) diff(context int, color bool) string {
+	return unifiedDiff(m.expected, m.got, diffOptions{
+		fromName: "expected",
+		toName:   "got",
+		fromLine: m.expLine,
+		toLine:   m.gotLine,
+		context:  context,
+		color:    color,
+	})
+}
+
+// mismatchError is the error of a test whose output does not match its
+// result file. It keeps the diffs rendered with and without color, so that
+// the console can show the colored one while reports get the plain one.
+type mismatchError struct {
+	msg     string
+	plain   string
+	colored string
+}
+
+func (e *mismatchError) Error() string {
+	return e.msg
+}
+
+// newMismatchError formats msg, followed by the diffs of mismatches, each
+// after the statement it starts at if withStmt is set.
+func newMismatchError(msg string, mismatches []*mismatch, context int, withStmt bool) *mismatchError {
+	var plain, colored strings.Builder
+	for _, m := range mismatches {
+		if withStmt {
+			header := fmt.Sprintf("\nline %d: %s\n", m.line, m.query)
+			if m.line < 0 {
+				header = fmt.Sprintf("\n%s:\n", m.query)
+			}
+			plain.WriteString(header)
+			colored.WriteString(header)
+		}
+		plain.WriteString(m.diff(context, false))
+		colored.WriteString(m.diff(context, true))
+	}
+	return &mismatchError{msg: msg + plain.String(), plain: plain.String(), colored: colored.String()}
+}
+
+// coloredMessage returns the message of err with the diff of a mismatch
+// colored, if err is a mismatch.
+func coloredMessage(err error) string {
+	msg := err.Error()
+	if me, ok := errors.Cause(err).(*mismatchError); ok && me.plain != "" {
+		msg = strings.Replace(msg, me.plain, me.colored, 1)
+	}
+	return msg
+}
+
+// ResultChecker compares the output of a test with its result file while
+// the test runs. The output is compared statement by statement at the same
+// offset in both streams. When collecting all mismatches, a mismatch does
+// not stop the test: the following statements are looked up in the result
+// file by their echoed query text and the comparison goes on from there.
+type ResultChecker struct {
+	expected []byte
+	// shift is the offset in expected of the start of the output.
+	shift int
+	// collect keeps going after a mismatch.
+	collect bool
+	// context is the number of unchanged lines around the changes of diffs.
+	context    int
+	mismatches []*mismatch
+	// mismatched is set once the output differed from the result file.
+	mismatched bool
+	// finished is set once the whole test ran.
+	finished bool
+
+	// pending is the last mismatch while the streams are out of sync, it
+	// started at pendingGot in the output and at pendingExp in expected.
+	// Both streams differ from searchFrom in expected on.
+	pending    *mismatch
+	pendingGot int
+	pendingExp int
+	searchFrom int
+}
+
+// NewResultChecker returns a checker of output against expected, the content
+// of a result file. With collect it reports every mismatch at the end rather
+// than the first one, with context unchanged lines around the changes.
+func NewResultChecker(expected []byte, collect bool, context int) *ResultChecker {
+	return &ResultChecker{expected: expected, collect: collect, context: context}
+}
+
+// Check compares out[offset:], the output of the statement q, with the
+// result file. echo is the query text written at the start of the output,
+// if the query log is enabled, used to synchronize both streams again. out
+// is the whole output so far, starting with the one of the previous
+// statements.
+func (c *ResultChecker) Check(q Query, out []byte, offset int, echo string) error {
+	if c.pending != nil {
+		if !c.resync(out, offset, echo) {
+			return nil
+		}
+	}
+	got := out[offset:]
+	start := offset + c.shift
+	end := start + len(got)
+	var exp []byte
+	if start < len(c.expected) {
+		exp = c.expected[start:min(end, len(c.expected))]
+	}
+	if bytes.Equal(got, exp) {
+		return nil
+	}
+	c.mismatched = true
+	m := &mismatch{
+		line:    q.Line,
+		query:   q.Query,
+		expLine: lineOf(c.expected, start),
+		gotLine: lineOf(out, offset),
+	}
+	if !c.collect {
+		m.expected, m.got = exp, got
+		return newMismatchError(fmt.Sprintf("failed to run query \n\"%v\" \n around line %d, \n", q.Query, q.Line), []*mismatch{m}, c.context, false)
+	}
+	c.pending = m
+	c.pendingGot, c.pendingExp = offset, min(start, len(c.expected))
+	c.searchFrom = c.pendingExp + commonPrefix(got, exp)
+	return nil
+}
+
+// resync looks for the echo of the statement starting at out[offset:] in
+// the rest of the result file. Once found, the pending mismatch is closed
+// and the output is compared from there.
+func (c *ResultChecker) resync(out []byte, offset int, echo string) bool {
+	if echo == "" {
+		return false
+	}
+	p := indexLine(c.expected, c.searchFrom, echo)
+	if p < 0 {
+		return false
+	}
+	c.closePending(out[:offset], p)
+	c.shift = p - offset
+	return true
+}
+
+// closePending records the pending mismatch, made of the output up to the
+// end of out and of the expected result up to expEnd.
+func (c *ResultChecker) closePending(out []byte, expEnd int) {
+	c.pending.got = append([]byte(nil), out[c.pendingGot:]...)
+	c.pending.expected = c.expected[c.pendingExp:expEnd]
+	c.mismatches = append(c.mismatches, c.pending)
+	c.pending = nil
+}
+
+// Mismatched reports whether the output differed from the result file.
+func (c *ResultChecker) Mismatched() bool {
+	return c.mismatched
+}
+
+// Finish checks the end of the result file once the test ran, out being
+// its whole output, and returns every mismatch found.
+func (c *ResultChecker) Finish(out []byte) error {
+	c.finished = true
+	if c.pending != nil {
+		c.closePending(out, len(c.expected))
+	} else if end := len(out) + c.shift; end < len(c.expected) {
+		c.mismatched = true
+		extra := c.expected[end:]
+		if !c.collect {
+			return errors.Errorf("There is extra data at the end of the result file: %s", extra[:min(len(extra), 32)])
+		}
+		c.mismatches = append(c.mismatches, &mismatch{
+			line:     -1,
+			query:    "end of the test",
+			expected: extra,
+			expLine:  lineOf(c.expected, end),
+			gotLine:  lineOf(out, len(out)),
+		})
+	}
+	if len(c.mismatches) == 0 {
+		return nil
+	}
+	return newMismatchError(fmt.Sprintf("%d statements do not match the result file\n", len(c.mismatches)), c.mismatches, c.context, true)
+}
+
+// lineOf returns the line number of the offset in buf.
+func lineOf(buf []byte, offset int) int {
+	return bytes.Count(buf[:min(offset, len(buf))], []byte("\n")) + 1
+}
+
+func commonPrefix(a, b []byte) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// indexLine returns the offset of the first line of buf starting at from
+// which begins with s, or -1.
+func indexLine(buf []byte, from int, s string) int {
+	for from <= len(buf) {
+		i := bytes.Index(buf[from:], []byte(s))
+		if i < 0 {
+			return -1
+		}
+		p := from + i
+		if p == 0 || buf[p-1] == '\n' {
+			return p
+		}
+		from = p + 1
+	}
+	return -1
+}
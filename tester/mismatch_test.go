@@ -0,0 +1,76 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// runChecker feeds the output of statements to c as tester.execute does.
+func runChecker(c *ResultChecker, stmts []Query, outputs []string) error {
+	var out []byte
+	for i, q := range stmts {
+		offset := len(out)
+		out = append(out, outputs[i]...)
+		if err := c.Check(q, out, offset, q.Query+"\n"); err != nil {
+			return err
+		}
+	}
+	return c.Finish(out)
+}
+
+func TestResultCheckerCollect(t *testing.T) {
+	expected := "select 1;\n1\n1\nselect 2;\n2\n2\nselect 3;\n3\n3\nselect 4;\n4\n4\n"
+	stmts := []Query{
+		{Query: "select 1;", Line: 1},
+		{Query: "select 2;", Line: 2},
+		{Query: "select 3;", Line: 3},
+		{Query: "select 4;", Line: 4},
+	}
+	outputs := []string{
+		"select 1;\n1\n1\n",
+		"select 2;\n2\n2\nextra row\n",
+		"select 3;\n3\n3\n",
+		"select 4;\n4\nfour\n",
+	}
+
+	err := runChecker(NewResultChecker([]byte(expected), false, 3), stmts, outputs)
+	require.ErrorContains(t, err, "around line 2")
+
+	c := NewResultChecker([]byte(expected), true, 3)
+	err = runChecker(c, stmts, outputs)
+	require.Len(t, c.mismatches, 2)
+	require.Equal(t, 2, c.mismatches[0].line)
+	require.Equal(t, "select 2;\n2\n2\n", string(c.mismatches[0].expected))
+	require.Equal(t, "select 2;\n2\n2\nextra row\n", string(c.mismatches[0].got))
+	require.Equal(t, 4, c.mismatches[1].line)
+	require.Equal(t, "select 4;\n4\n4\n", string(c.mismatches[1].expected))
+	require.ErrorContains(t, err, "2 statements do not match the result file")
+	require.ErrorContains(t, err, "line 4: select 4;")
+}
+
+func TestResultCheckerExtraData(t *testing.T) {
+	stmts := []Query{{Query: "select 1;", Line: 1}}
+	err := runChecker(NewResultChecker([]byte("select 1;\n1\n1\nselect 2;\n"), false, 3), stmts, []string{"select 1;\n1\n1\n"})
+	require.ErrorContains(t, err, "extra data at the end of the result file: select 2;")
+
+	c := NewResultChecker([]byte("select 1;\n1\n1\nselect 2;\n"), true, 3)
+	err = runChecker(c, stmts, []string{"select 1;\n1\n1\n"})
+	require.ErrorContains(t, err, "end of the test")
+
+	err = runChecker(NewResultChecker([]byte("select 1;\n1\n1\n"), true, 3), stmts, []string{"select 1;\n1\n1\n"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Options are the settings of a run. The zero value is not usable, start
+// from DefaultOptions.
+type Options struct {
+	// Dir is the directory holding the t and r directories of the tests
+	// and their results.
+	Dir string
+
+	// Host, Port, User and Password locate the TiDB/MySQL server.
+	Host     string
+	Port     string
+	User     string
+	Password string
+	// Params are additional DSN parameters, e.g. session variables.
+	Params string
+	// TimeZone is the time_zone of the sessions.
+	TimeZone string
+	// AllowAllFiles allows LOAD DATA LOCAL INFILE of any file.
+	AllowAllFiles bool
+	// InitSQL are statements run on every new connection. If nil, a TiDB
+	// server gets DefaultTiDBInitSQL.
+	InitSQL []string
+	// RetryConnCount is the max number of attempts to connect to the server.
+	RetryConnCount int
+	// Target is the name of the configuration target the options come from,
+	// if any, see Config.
+	Target string
+	// Dialect names the dialect of the server instead of detecting it, e.g.
+	// for an engine the probes do not know, see Dialect.
+	Dialect string
+	// Capabilities are the capabilities of the server, instead of the ones
+	// its dialect is known to have, if not nil.
+	Capabilities []string
+	// Reference are the options of the server whose output is the expected
+	// one of the tests, instead of their result files, see runDifferential.
+	Reference *Options
+	// Flags are the names of the mysql-tester flags set on the command line,
+	// e.g. check-error. The suite files do not override their options.
+	Flags []string
+
+	// RecordMode writes the output of the tests to their result files
+	// instead of checking it, unless it is RecordNone.
+	RecordMode RecordMode
+	// ReserveSchema keeps the schema of each test after it ran.
+	ReserveSchema bool
+	// CheckErr fails a test when an --error does not match, instead of just
+	// warning.
+	CheckErr bool
+	// CollationDisable runs the collation tests with the new collation
+	// disabled.
+	CollationDisable bool
+	// Extension is the extension of the result files.
+	Extension string
+	// ErrorCatalogs are error catalog files adding error names for --error,
+	// to load with LoadErrorCatalogs.
+	ErrorCatalogs []string
+
+	// Parallel is the number of tests running at the same time.
+	Parallel int
+	// StmtTimeout and TestTimeout fail a statement or a test running longer,
+	// 0 means no limit. A test may declare its own @timeout.
+	StmtTimeout time.Duration
+	TestTimeout time.Duration
+	// Retries is the number of times a failed test is retried in a fresh
+	// schema, a test passing after a retry is flaky.
+	Retries int
+
+	// IncludeTags selects the tests having one of them, ExcludeTags drops
+	// the tests having one of them.
+	IncludeTags []string
+	ExcludeTags []string
+	// Run selects the tests whose name matches it, Exclude drops the ones
+	// matching it, if set.
+	Run     string
+	Exclude string
+	// SkipFile lists the tests reported as skipped, see skipEntry.
+	SkipFile string
+	// Shard runs the i-th of n parts of the selected tests, as "i/n".
+	Shard string
+	// ShardDurations is the XUnit report of a previous run, balancing the
+	// shards by duration.
+	ShardDurations string
+	// FailedFile records the tests which failed. RerunFailed runs the ones
+	// of the last run instead of the given tests.
+	FailedFile  string
+	RerunFailed bool
+
+	// AllMismatches keeps running a test after a mismatch and reports all
+	// of them at its end.
+	AllMismatches bool
+	// DiffContext is the number of unchanged lines around changes in diffs.
+	DiffContext int
+	// Color colors the diffs printed on the console.
+	Color bool
+	// XUnitFile is the XUnit report written during the run, if set.
+	XUnitFile string
+}
+
+// DefaultTiDBInitSQL is the init SQL of the connections to a TiDB server
+// when the options set none. It keeps the results of the TiDB tests stable,
+// e.g. small chunks and serial hash joins.
+var DefaultTiDBInitSQL = []string{
+	"SET @@tidb_init_chunk_size = 1",
+	"SET @@tidb_max_chunk_size = 32",
+	"SET @@tidb_multi_statement_mode = 1",
+	"SET @@tidb_hash_join_concurrency = 1",
+	"SET @@tidb_enable_pseudo_for_outdated_stats = false",
+	// let analyze requests with SI isolation level get accurate responses
+	"SET @@tidb_enable_analyze_snapshot = 1",
+	"SET @@tidb_enable_clustered_index = 'int_only'",
+}
+
+// DefaultOptions returns the options of a run with the default value of
+// every flag of mysql-tester.
+func DefaultOptions() Options {
+	return Options{
+		Dir:            ".",
+		Host:           "127.0.0.1",
+		Port:           "3306",
+		User:           "root",
+		TimeZone:       "Asia/Shanghai",
+		AllowAllFiles:  true,
+		RetryConnCount: 120,
+		Extension:      "result",
+		Parallel:       1,
+		FailedFile:     ".failed_tests",
+		DiffContext:    3,
+	}
+}
+
+// recording reports whether the output of the tests is recorded instead of
+// being checked.
+func (o *Options) recording() bool {
+	return o.RecordMode != RecordNone
+}
+
+// initSQL returns the statements run on every new connection: InitSQL, or
+// DefaultTiDBInitSQL if it is nil and the dialect or the target is tidb.
+func (o *Options) initSQL() []string {
+	if o.InitSQL == nil && (strings.EqualFold(o.Dialect, DialectTiDB) || o.Target == DialectTiDB) {
+		return DefaultTiDBInitSQL
+	}
+	return o.InitSQL
+}
+
+// server returns the address of the server.
+func (o *Options) server() string {
+	return o.Host + ":" + o.Port
+}
+
+// testFileName returns the file of the test name.
+func (o *Options) testFileName(name string) string {
+	// test and result must be in current ./t the same as MySQL
+	return filepath.Join(o.Dir, "t", name+".test")
+}
+
+// resultDir is the directory of the result files.
+func (o *Options) resultDir() string {
+	return filepath.Join(o.Dir, "r")
+}
+
+// resultFileName returns the result file of the test name, the first of
+// resultFileNames which exists, or the last one if none does. The result is
+// both read and recorded there.
+func (o *Options) resultFileName(name string) string {
+	files := o.resultFileNames(name)
+	for _, file := range files[:len(files)-1] {
+		if _, err := os.Stat(file); err == nil {
+			return file
+		}
+	}
+	return files[len(files)-1]
+}
+
+// resultFileNames returns the result files the test name may have, the most
+// specific first: r/<target>/<name>.result, r/<name>.<target>.result and
+// r/<name>.result, the only one without a Target.
+func (o *Options) resultFileNames(name string) []string {
+	// test and result must be in current ./r, the same as MySQL
+	if hasCollationPrefix(name) {
+		if o.CollationDisable {
+			name = name + "_disabled"
+		} else {
+			name = name + "_enabled"
+		}
+	}
+	generic := filepath.Join(o.resultDir(), fmt.Sprintf("%s.%s", name, o.Extension))
+	if o.Target == "" {
+		return []string{generic}
+	}
+	return []string{
+		filepath.Join(o.resultDir(), o.Target, fmt.Sprintf("%s.%s", name, o.Extension)),
+		filepath.Join(o.resultDir(), fmt.Sprintf("%s.%s.%s", name, o.Target, o.Extension)),
+		generic,
+	}
+}
+
+// resultVariantTest returns the test name of a result file name relative to
+// the result directory, without its extension, e.g. quickbi/interval for
+// tidb/quickbi/interval or quickbi/interval.tidb with the target tidb.
+func (o *Options) resultVariantTest(name string) string {
+	if o.Target == "" {
+		return name
+	}
+	if rest, ok := strings.CutPrefix(name, o.Target+"/"); ok {
+		return rest
+	}
+	return strings.TrimSuffix(name, "."+o.Target)
+}
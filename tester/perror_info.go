@@ -0,0 +1,57 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PrintErrorInfo prints the name, SQLSTATE, message template and aliases
+// of the error code or name arg, like MySQL's perror.
+func PrintErrorInfo(w io.Writer, arg string) error {
+	code, err := strconv.Atoi(arg)
+	if err != nil {
+		var ok bool
+		if code, ok = MysqlErrNameToNum[arg]; !ok {
+			return fmt.Errorf("unknown error name %s", arg)
+		}
+	}
+	info, ok := MysqlErrNumToInfo[code]
+	if !ok {
+		return fmt.Errorf("unknown error code %d", code)
+	}
+	line := fmt.Sprintf("%d (%s)", code, info.Name)
+	if info.SQLState != "" {
+		line += " SQLSTATE " + info.SQLState
+	}
+	if info.Message != "" {
+		line += ": " + info.Message
+	}
+	fmt.Fprintln(w, line)
+	var aliases []string
+	for k, v := range MysqlErrNameToNum {
+		if v == code && k != info.Name {
+			aliases = append(aliases, k)
+		}
+	}
+	if len(aliases) > 0 {
+		sort.Strings(aliases)
+		fmt.Fprintf(w, "\taliases: %s\n", strings.Join(aliases, ", "))
+	}
+	return nil
+}
@@ -11,9 +11,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package tester
 
 import (
+	"bytes"
 	"strings"
 
 	"github.com/pingcap/errors"
@@ -22,6 +23,34 @@ import (
 
 var ErrInvalidCommand = errors.New("Found line beginning with -- that didn't contain a valid mysqltest command, check your syntax or use # if you intended to write comment")
 
+// Query is a statement or a command of a test.
+type Query struct {
+	firstWord string
+	// Query is the text of the statement, or the arguments of the command.
+	Query     string
+	delimiter string
+	// Line is the line of the test file it ends at.
+	Line int
+	tp   int
+}
+
+// NewQuery returns the text of a statement or a command ending at line of a
+// test file, for ParseQuery, with the current delimiter of the file.
+func NewQuery(query, delimiter string, line int) Query {
+	return Query{Query: query, delimiter: delimiter, Line: line}
+}
+
+// Type returns the Q_* type of the query: Q_QUERY for a statement, the one
+// of its command otherwise.
+func (q *Query) Type() int {
+	return q.tp
+}
+
+// Delimiter returns the delimiter ending the query.
+func (q *Query) Delimiter() string {
+	return q.delimiter
+}
+
 // Different query command type
 const (
 	Q_CONNECTION = iota + 1
@@ -120,6 +149,9 @@ const (
 	Q_SINGLE_QUERY
 	Q_BEGIN_CONCURRENT
 	Q_END_CONCURRENT
+	Q_REQUIRE_DIALECT
+	Q_REQUIRE_VERSION
+	Q_REQUIRE_CAPABILITY
 	Q_UNKNOWN /* Unknown command.   */
 	Q_COMMENT /* Comments, ignored. */
 	Q_COMMENT_WITH_COMMAND
@@ -128,10 +160,10 @@ const (
 
 // ParseQuery parses an array of string into an array of query object.
 // Note: a query statement may reside in several lines.
-func ParseQuery(rs query) (*query, error) {
+func ParseQuery(rs Query) (*Query, error) {
 	realS := rs.Query
 	s := rs.Query
-	q := query{delimiter: rs.delimiter, Line: rs.Line}
+	q := Query{delimiter: rs.delimiter, Line: rs.Line}
 	q.tp = Q_UNKNOWN
 	// a valid query's length should be at least 3.
 	if len(s) < 3 {
@@ -179,9 +211,9 @@ func ParseQuery(rs query) (*query, error) {
 	return &q, nil
 }
 
-// for a single query, it has some prefix. Prefix mapps to a query type.
+// for a single Query, it has some prefix. Prefix mapps to a query type.
 // e.g query_vertical maps to Q_QUERY_VERTICAL
-func (q *query) getQueryType(qu string) error {
+func (q *Query) getQueryType(qu string) error {
 	tp := findType(q.firstWord)
 	if tp > 0 {
 		if tp == Q_ECHO || tp == Q_SORTED_RESULT {
@@ -201,3 +233,88 @@ func (q *query) getQueryType(qu string) error {
 	}
 	return nil
 }
+
+// ParseQueries parses the content of a test file into its statements and
+// commands. Comments are dropped and DELIMITER changes the delimiter of the
+// following statements.
+func ParseQueries(data []byte) ([]Query, error) {
+	// the delimter for TiDB, default value is ";"
+	delimiter := ";"
+	seps := bytes.Split(data, []byte("\n"))
+	queries := make([]Query, 0, len(seps))
+	buffer := ""
+	for i, v := range seps {
+		v := bytes.TrimSpace(v)
+		s := string(v)
+		// we will skip # comment here
+		if strings.HasPrefix(s, "#") {
+			if len(buffer) != 0 {
+				return nil, errors.Errorf("Has remained message(%s) before COMMENTS", buffer)
+			}
+			continue
+		} else if strings.HasPrefix(s, "--") {
+			if len(buffer) != 0 {
+				return nil, errors.Errorf("Has remained message(%s) before COMMANDS", buffer)
+			}
+			q, err := ParseQuery(Query{Query: s, Line: i + 1, delimiter: delimiter})
+			if err != nil {
+				return nil, err
+			}
+			if q == nil {
+				continue
+			}
+			if q.tp == Q_DELIMITER {
+				tokens := strings.Split(strings.TrimSpace(q.Query), " ")
+				if len(tokens) == 0 {
+					return nil, errors.Errorf("DELIMITER must be followed by a 'delimiter' character or string")
+				}
+				delimiter = tokens[0]
+			} else {
+				queries = append(queries, *q)
+			}
+			continue
+		} else if strings.HasPrefix(strings.ToLower(strings.TrimSpace(s)), "delimiter ") {
+			if len(buffer) != 0 {
+				return nil, errors.Errorf("Has remained message(%s) before DELIMITER COMMAND", buffer)
+			}
+			tokens := strings.Split(strings.TrimSpace(s), " ")
+			if len(tokens) <= 1 {
+				return nil, errors.Errorf("DELIMITER must be followed by a 'delimiter' character or string")
+			}
+			delimiter = tokens[1]
+			continue
+		} else if len(s) == 0 {
+			continue
+		}
+
+		if len(buffer) != 0 {
+			buffer += "\n"
+		}
+		buffer += s
+		for {
+			idx := strings.LastIndex(buffer, delimiter)
+			if idx == -1 {
+				break
+			}
+
+			queryStr := buffer[:idx+len(delimiter)]
+			buffer = buffer[idx+len(delimiter):]
+			q, err := ParseQuery(Query{Query: strings.TrimSpace(queryStr), Line: i + 1, delimiter: delimiter})
+			if err != nil {
+				return nil, err
+			}
+			if q == nil {
+				continue
+			}
+			queries = append(queries, *q)
+		}
+		// If has remained comments, ignore them.
+		if len(buffer) != 0 && strings.HasPrefix(strings.TrimSpace(buffer), "#") {
+			buffer = ""
+		}
+	}
+	if len(buffer) != 0 {
+		return nil, errors.Errorf("Has remained text(%s) in file", buffer)
+	}
+	return queries, nil
+}
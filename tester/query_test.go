@@ -11,7 +11,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package tester
 
 import (
 	"fmt"
@@ -35,7 +35,7 @@ func assertEqual(t *testing.T, a interface{}, b interface{}, message string) {
 func TestParseQueryies(t *testing.T) {
 	sql := "select * from t;"
 
-	if q, err := ParseQuery(query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
+	if q, err := ParseQuery(Query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
 		assertEqual(t, q.tp, Q_QUERY, fmt.Sprintf("Expected: %d, got: %d", Q_QUERY, q.tp))
 		assertEqual(t, q.Query, sql, fmt.Sprintf("Expected: %s, got: %s", sql, q.Query))
 	} else {
@@ -43,7 +43,7 @@ func TestParseQueryies(t *testing.T) {
 	}
 
 	sql = "--sorted_result select * from t;"
-	if q, err := ParseQuery(query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
+	if q, err := ParseQuery(Query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
 		assertEqual(t, q.tp, Q_SORTED_RESULT, "sorted_result")
 		assertEqual(t, q.Query, "select * from t;", fmt.Sprintf("Expected: '%s', got '%s'", "select * from t;", q.Query))
 	} else {
@@ -52,15 +52,31 @@ func TestParseQueryies(t *testing.T) {
 
 	// invalid comment command style
 	sql = "--abc select * from t;"
-	_, err := ParseQuery(query{Query: sql, Line: 1, delimiter: ";"})
+	_, err := ParseQuery(Query{Query: sql, Line: 1, delimiter: ";"})
 	assertEqual(t, err, ErrInvalidCommand, fmt.Sprintf("Expected: %v, got %v", ErrInvalidCommand, err))
 
 	sql = "--let $foo=`SELECT 1`"
-	if q, err := ParseQuery(query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
+	if q, err := ParseQuery(Query{Query: sql, Line: 1, delimiter: ";"}); err == nil {
 		assertEqual(t, q.tp, Q_LET, fmt.Sprintf("Expected: %d, got: %d", Q_LET, q.tp))
 	}
 }
 
+func TestQueryAccessors(t *testing.T) {
+	q, err := ParseQuery(NewQuery("select 1 |", "|", 3))
+	assert.NoError(t, err)
+	assert.Equal(t, Q_QUERY, q.Type())
+	assert.Equal(t, "|", q.Delimiter())
+	assert.Equal(t, 3, q.Line)
+
+	queries, err := ParseQueries([]byte("--echo hi\ndelimiter |\nselect 1|\n"))
+	assert.NoError(t, err)
+	assert.Len(t, queries, 2)
+	assert.Equal(t, Q_ECHO, queries[0].Type())
+	assert.Equal(t, "hi", queries[0].Query)
+	assert.Equal(t, Q_QUERY, queries[1].Type())
+	assert.Equal(t, "|", queries[1].Delimiter())
+}
+
 func TestLoadQueries(t *testing.T) {
 	dir := t.TempDir()
 	err := os.Chdir(dir)
@@ -71,25 +87,25 @@ func TestLoadQueries(t *testing.T) {
 
 	testCases := []struct {
 		input   string
-		queries []query
+		queries []Query
 	}{
 		{
 			input: "delimiter |\n do something; select something; |\n delimiter ; \nselect 1;",
-			queries: []query{
+			queries: []Query{
 				{Query: "do something; select something; |", tp: Q_QUERY, delimiter: "|"},
 				{Query: "select 1;", tp: Q_QUERY, delimiter: ";"},
 			},
 		},
 		{
 			input: "delimiter |\ndrop procedure if exists scopel\ncreate procedure scope(a int, b float)\nbegin\ndeclare b int;\ndeclare c float;\nbegin\ndeclare c int;\nend;\nend |\ndrop procedure scope|\ndelimiter ;\n",
-			queries: []query{
+			queries: []Query{
 				{Query: "drop procedure if exists scopel\ncreate procedure scope(a int, b float)\nbegin\ndeclare b int;\ndeclare c float;\nbegin\ndeclare c int;\nend;\nend |", tp: Q_QUERY, delimiter: "|"},
 				{Query: "drop procedure scope|", tp: Q_QUERY, delimiter: "|"},
 			},
 		},
 		{
 			input: "--error 1054\nselect 1;",
-			queries: []query{
+			queries: []Query{
 				{Query: " 1054", tp: Q_ERROR, delimiter: ";"},
 				{Query: "select 1;", tp: Q_QUERY, delimiter: ";"},
 			},
@@ -104,7 +120,7 @@ func TestLoadQueries(t *testing.T) {
 		f.WriteString(testCase.input)
 		f.Close()
 
-		test := newTester("test")
+		test := testRunner().NewTester("test")
 		queries, err := test.loadQueries()
 		assert.NoError(t, err)
 		assert.Len(t, queries, len(testCase.queries))
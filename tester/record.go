@@ -0,0 +1,124 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// RecordMode tells what recording does with the output of the tests.
+type RecordMode string
+
+// Modes of -record.
+const (
+	// RecordNone checks the output against the result files.
+	RecordNone RecordMode = ""
+	// RecordAll rewrites every result file.
+	RecordAll RecordMode = "all"
+	// RecordChanged only rewrites the result files whose content changed.
+	RecordChanged RecordMode = "changed"
+	// RecordDryRun prints the diff of the result files instead of writing.
+	RecordDryRun RecordMode = "dry-run"
+)
+
+// ParseRecordMode parses the value of -record: a boolean, changed or
+// dry-run.
+func ParseRecordMode(s string) (RecordMode, error) {
+	switch RecordMode(s) {
+	case RecordAll, RecordChanged, RecordDryRun:
+		return RecordMode(s), nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return RecordNone, errors.Errorf("must be a boolean, %s or %s", RecordChanged, RecordDryRun)
+	}
+	if b {
+		return RecordAll, nil
+	}
+	return RecordNone, nil
+}
+
+// recordStats counts the result files by what recording did to them.
+type recordStats struct {
+	sync.Mutex
+	created   []string
+	modified  []string
+	unchanged []string
+}
+
+// recordResult writes the output of a recorded test to its result file,
+// as told by RecordMode, and counts it in recordStats.
+func (t *Tester) recordResult(path string) error {
+	out := t.buf.Bytes()
+	old, err := os.ReadFile(path)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Trace(err)
+	}
+
+	stats := &t.r.recordStats
+	stats.Lock()
+	switch {
+	case !exists:
+		stats.created = append(stats.created, path)
+	case bytes.Equal(old, out):
+		stats.unchanged = append(stats.unchanged, path)
+	default:
+		stats.modified = append(stats.modified, path)
+	}
+	stats.Unlock()
+
+	switch {
+	case t.opts.RecordMode == RecordDryRun:
+		if !exists || !bytes.Equal(old, out) {
+			diff := unifiedDiff(old, out, diffOptions{
+				fromName: path,
+				toName:   t.name + " output",
+				context:  t.opts.DiffContext,
+				color:    t.opts.Color,
+			})
+			fmt.Print(diff)
+		}
+		return nil
+	case t.opts.RecordMode == RecordChanged && exists && bytes.Equal(old, out):
+		return nil
+	}
+	return errors.Trace(writeFileAtomic(path, out, 0644))
+}
+
+// printRecordSummary prints what recording did to the result files.
+func (r *Runner) printRecordSummary() {
+	recordStats := &r.recordStats
+	recordStats.Lock()
+	defer recordStats.Unlock()
+	created, modified := "created", "modified"
+	if r.opts.RecordMode == RecordDryRun {
+		created, modified = "would be created", "would be modified"
+	}
+	for _, name := range recordStats.created {
+		log.Infof("%s %s", name, created)
+	}
+	for _, name := range recordStats.modified {
+		log.Infof("%s %s", name, modified)
+	}
+	fmt.Printf("result files: %d %s, %d %s, %d unchanged\n",
+		len(recordStats.created), created, len(recordStats.modified), modified, len(recordStats.unchanged))
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecordMode(t *testing.T) {
+	for s, mode := range map[string]RecordMode{
+		"true":    RecordAll,
+		"1":       RecordAll,
+		"all":     RecordAll,
+		"changed": RecordChanged,
+		"dry-run": RecordDryRun,
+		"false":   RecordNone,
+		"0":       RecordNone,
+	} {
+		got, err := ParseRecordMode(s)
+		require.NoError(t, err)
+		require.Equal(t, mode, got, s)
+	}
+	_, err := ParseRecordMode("sometimes")
+	require.Error(t, err)
+}
+
+func TestRecordResult(t *testing.T) {
+	dir := t.TempDir()
+	same := filepath.Join(dir, "same.result")
+	changed := filepath.Join(dir, "changed.result")
+	created := filepath.Join(dir, "created.result")
+	require.NoError(t, os.WriteFile(same, []byte("a\n"), 0644))
+	require.NoError(t, os.WriteFile(changed, []byte("a\n"), 0644))
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(same, old, old))
+
+	r := testRunner()
+	tr := r.NewTester("x")
+	tr.buf.WriteString("a\n")
+
+	r.opts.RecordMode = RecordDryRun
+	require.NoError(t, tr.recordResult(created))
+	require.NoFileExists(t, created)
+
+	r.opts.RecordMode = RecordChanged
+	require.NoError(t, tr.recordResult(same))
+	fi, err := os.Stat(same)
+	require.NoError(t, err)
+	require.True(t, fi.ModTime().Equal(old))
+
+	tr.buf.WriteString("b\n")
+	require.NoError(t, tr.recordResult(changed))
+	content, err := os.ReadFile(changed)
+	require.NoError(t, err)
+	require.Equal(t, "a\nb\n", string(content))
+
+	require.Equal(t, []string{created}, r.recordStats.created)
+	require.Equal(t, []string{changed}, r.recordStats.modified)
+	require.Equal(t, []string{same}, r.recordStats.unchanged)
+}
+
+func TestResultFileVariants(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Dir = t.TempDir()
+	dir := filepath.Join(opts.Dir, "r")
+	require.Equal(t, []string{filepath.Join(dir, "quickbi/interval.result")}, opts.resultFileNames("quickbi/interval"))
+
+	opts.Target = "tidb"
+	// nothing exists yet, the generic result is recorded
+	require.Equal(t, filepath.Join(dir, "quickbi/interval.result"), opts.resultFileName("quickbi/interval"))
+	for _, c := range []struct {
+		create string
+		want   string
+	}{
+		{"quickbi/interval.tidb.result", "quickbi/interval.tidb.result"},
+		{"quickbi/interval.result", "quickbi/interval.tidb.result"},
+		{"tidb/quickbi/interval.result", "tidb/quickbi/interval.result"},
+	} {
+		p := filepath.Join(dir, c.create)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, nil, 0644))
+		require.Equal(t, filepath.Join(dir, c.want), opts.resultFileName("quickbi/interval"), c.create)
+	}
+	opts.Target = "mysql"
+	require.Equal(t, filepath.Join(dir, "quickbi/interval.result"), opts.resultFileName("quickbi/interval"))
+
+	opts.Target = "tidb"
+	require.Equal(t, "quickbi/interval", opts.resultVariantTest("tidb/quickbi/interval"))
+	require.Equal(t, "quickbi/interval", opts.resultVariantTest("quickbi/interval.tidb"))
+	require.Equal(t, "mysql/quickbi/interval", opts.resultVariantTest("mysql/quickbi/interval"))
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const rejectExt = ".reject"
+
+// partialExt suffixes the marker of a reject file which only holds the
+// output up to the first mismatch, which accept refuses.
+const partialExt = ".partial"
+
+// rejectFileName returns the file the output of a mismatching test is
+// written to, next to its result file, as MySQL's mysqltest does.
+func (t *Tester) rejectFileName() string {
+	return strings.TrimSuffix(t.resultFileName(), "."+t.opts.Extension) + rejectExt
+}
+
+// writeRejectFile writes the output of the test to rejectFileName if it did
+// not match its result file, and removes a stale one otherwise. Unless the
+// test ran to its end, e.g. with AllMismatches, the output stops at the
+// first mismatching statement and the reject is marked partial.
+func (t *Tester) writeRejectFile() error {
+	if t.checker == nil {
+		return nil
+	}
+	path := t.rejectFileName()
+	if !t.checker.mismatched {
+		return removeFiles(path, path+partialExt)
+	}
+	if err := writeFileAtomic(path, t.buf.Bytes(), 0644); err != nil {
+		return errors.Trace(err)
+	}
+	if t.checker.finished {
+		return removeFiles(path + partialExt)
+	}
+	log.Warnf("%s: %s only holds the output up to the first mismatch, run with -all-mismatches for the whole output", t.name, path)
+	return errors.Trace(writeFileAtomic(path+partialExt, []byte("the test stopped at its first mismatch, run it with -all-mismatches for the whole output\n"), 0644))
+}
+
+// removeFiles removes the files which exist.
+func removeFiles(paths ...string) error {
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// findRejects returns the reject files under dir, as paths relative to it
+// without the extension, sorted.
+func findRejects(dir string) ([]string, error) {
+	var rejects []string
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(p, rejectExt) {
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+			rejects = append(rejects, strings.TrimSuffix(filepath.ToSlash(rel), rejectExt))
+		}
+		return nil
+	})
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	sort.Strings(rejects)
+	return rejects, errors.Trace(err)
+}
+
+// selectRejects keeps the rejects matching one of the test names or globs,
+// or all of them if none is given. A name also matches the rejects of its
+// collation variants, e.g. collation/x matches collation/x_enabled, and the
+// rejects are matched by the test name testName returns for them.
+func selectRejects(rejects, args []string, testName func(string) string) ([]string, error) {
+	if len(args) == 0 {
+		return rejects, nil
+	}
+	var ret []string
+	seen := make(map[string]struct{})
+	for _, arg := range args {
+		pattern := normalizeTestName(arg)
+		matched := false
+		for _, reject := range rejects {
+			name := testName(reject)
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, errors.Annotatef(err, "invalid test pattern %q", arg)
+			}
+			if !ok && name != pattern+"_enabled" && name != pattern+"_disabled" {
+				continue
+			}
+			matched = true
+			if _, dup := seen[reject]; !dup {
+				seen[reject] = struct{}{}
+				ret = append(ret, reject)
+			}
+		}
+		if !matched {
+			return nil, errors.Errorf("no reject file for %q", arg)
+		}
+	}
+	return ret, nil
+}
+
+// acceptReject promotes r/<name>.reject to the result file of the test,
+// with the given extension, and removes its diff file. A partial reject
+// would truncate the result file, it is refused.
+func acceptReject(dir, name, extension string) (string, error) {
+	base := filepath.Join(dir, filepath.FromSlash(name))
+	if _, err := os.Stat(base + rejectExt + partialExt); err == nil {
+		return "", errors.Errorf("%s only holds the output up to the first mismatch, run the test again with -all-mismatches to accept it", base+rejectExt)
+	}
+	result := base + "." + extension
+	if err := os.Rename(base+rejectExt, result); err != nil {
+		return "", errors.Trace(err)
+	}
+	return result, errors.Trace(removeFiles(base + ".diff"))
+}
+
+// AcceptRejects promotes the reject files of the given tests, or of every
+// test, to their result files and reports them to w. It returns the exit
+// code of the accept subcommand.
+func AcceptRejects(w io.Writer, opts Options, args []string) int {
+	dir := opts.resultDir()
+	rejects, err := findRejects(dir)
+	if err == nil {
+		rejects, err = selectRejects(rejects, args, opts.resultVariantTest)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if len(rejects) == 0 {
+		fmt.Fprintln(w, "no reject file to accept")
+		return 0
+	}
+	ret := 0
+	for _, name := range rejects {
+		result, err := acceptReject(dir, name, opts.Extension)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			ret = 1
+			continue
+		}
+		fmt.Fprintf(w, "accepted %s\n", result)
+	}
+	return ret
+}
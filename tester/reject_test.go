@@ -0,0 +1,77 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptRejects(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Dir = t.TempDir()
+	dir := filepath.Join(opts.Dir, "r")
+	write := func(name, content string) {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+	write("quickbi/interval.result", "old\n")
+	write("quickbi/interval.reject", "new\n")
+	write("quickbi/interval.diff", "-old\n+new\n")
+	write("quickbi/date.reject", "date\n")
+	write("collation/c_enabled.reject", "c\n")
+	write("quickbi/partial.result", "a\nb\nc\n")
+	write("quickbi/partial.reject", "a\nx\n")
+	write("quickbi/partial.reject.partial", "")
+
+	rejects, err := findRejects(dir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"collation/c_enabled", "quickbi/date", "quickbi/interval", "quickbi/partial"}, rejects)
+
+	selected, err := selectRejects(rejects, []string{"t/quickbi/*", "quickbi/interval", "collation/c"}, opts.resultVariantTest)
+	require.NoError(t, err)
+	require.Equal(t, []string{"quickbi/date", "quickbi/interval", "quickbi/partial", "collation/c_enabled"}, selected)
+
+	// a partial reject would truncate the result file
+	var out bytes.Buffer
+	require.Equal(t, 1, AcceptRejects(&out, opts, []string{"quickbi/partial"}))
+	content, err := os.ReadFile(filepath.Join(dir, "quickbi/partial.result"))
+	require.NoError(t, err)
+	require.Equal(t, "a\nb\nc\n", string(content))
+	require.NoError(t, os.Remove(filepath.Join(dir, "quickbi/partial.reject")))
+	require.NoError(t, os.Remove(filepath.Join(dir, "quickbi/partial.reject.partial")))
+	_, err = selectRejects(rejects, []string{"example"}, opts.resultVariantTest)
+	require.Error(t, err)
+
+	out.Reset()
+	require.Equal(t, 0, AcceptRejects(&out, opts, []string{"quickbi/interval"}))
+	content, err = os.ReadFile(filepath.Join(dir, "quickbi/interval.result"))
+	require.NoError(t, err)
+	require.Equal(t, "new\n", string(content))
+	require.NoFileExists(t, filepath.Join(dir, "quickbi/interval.reject"))
+	require.NoFileExists(t, filepath.Join(dir, "quickbi/interval.diff"))
+	require.FileExists(t, filepath.Join(dir, "quickbi/date.reject"))
+
+	out.Reset()
+	require.Equal(t, 0, AcceptRejects(&out, opts, nil))
+	require.Contains(t, out.String(), "accepted "+filepath.Join(dir, "quickbi/date.result"))
+	out.Reset()
+	require.Equal(t, 0, AcceptRejects(&out, opts, nil))
+	require.Equal(t, "no reject file to accept\n", out.String())
+}
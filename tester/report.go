@@ -0,0 +1,238 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// infraError is an error of the environment a test runs in, e.g. the server
+// can not be reached or the schema of the test can not be created, rather
+// than a wrong result. It is reported as an XUnit error instead of a failure.
+type infraError struct {
+	err error
+}
+
+func newInfraError(err error) error {
+	return &infraError{err: err}
+}
+
+func (e *infraError) Error() string {
+	return e.err.Error()
+}
+
+// isInfraError reports whether err is or wraps an infraError. infraError
+// does not implement Cause, so errors.Cause stops at it.
+func isInfraError(err error) bool {
+	for err != nil {
+		if _, ok := err.(*infraError); ok {
+			return true
+		}
+		cause := errors.Cause(err)
+		if cause == err {
+			return false
+		}
+		err = cause
+	}
+	return false
+}
+
+// reportState is what the XUnit report needs besides the suite.
+type reportState struct {
+	// total is the number of selected tests.
+	total     int
+	startTime time.Time
+	goVersion string
+}
+
+// initReport starts the XUnit report of total tests, if XUnitFile is set,
+// and writes it empty, replacing the report of a previous run.
+func (r *Runner) initReport(total int, startTime time.Time) {
+	if r.opts.XUnitFile == "" {
+		return
+	}
+	r.suiteLock.Lock()
+	r.suite = XUnitTestSuite{
+		Properties: make([]XUnitProperty, 0),
+		TestCases:  make([]XUnitTestCase, 0),
+	}
+	r.report.total = total
+	r.report.startTime = startTime
+	r.report.goVersion = goVersion()
+	r.suiteLock.Unlock()
+	r.FlushReport()
+}
+
+// FlushReport writes the XUnit report of the tests run so far, if
+// XUnitFile is set. It is called after every test, so that an interrupted
+// run still leaves a complete report of the finished tests.
+func (r *Runner) FlushReport() {
+	if r.opts.XUnitFile == "" {
+		return
+	}
+	r.suiteLock.Lock()
+	suite := r.suite
+	suite.Tests = r.report.total
+	suite.Time = fmt.Sprintf("%fs", time.Since(r.report.startTime).Seconds())
+	suite.Properties = append(append([]XUnitProperty(nil), r.suite.Properties...), XUnitProperty{
+		Name:  "go.version",
+		Value: r.report.goVersion,
+	})
+	var buf bytes.Buffer
+	err := Write(&buf, suite)
+	r.suiteLock.Unlock()
+	if err == nil {
+		err = writeFileAtomic(r.opts.XUnitFile, buf.Bytes(), 0644)
+	}
+	if err != nil {
+		log.Error("Write xunit file fail:", err)
+	}
+}
+
+// recordInvalidTest records a test which could not even be loaded as failed.
+func (r *Runner) recordInvalidTest(task testTask) {
+	r.suiteLock.Lock()
+	defer r.suiteLock.Unlock()
+	r.suite.TestCases = append(r.suite.TestCases, XUnitTestCase{
+		Name:    reportTestName(task.test),
+		Failure: task.err.Error(),
+	})
+	r.suite.Failures++
+}
+
+// recordSkippedTest records a test left out by the skip file.
+func (r *Runner) recordSkippedTest(task testTask) {
+	r.suiteLock.Lock()
+	defer r.suiteLock.Unlock()
+	r.suite.TestCases = append(r.suite.TestCases, XUnitTestCase{
+		Name:    reportTestName(task.test),
+		Skipped: &XUnitSkipped{Message: task.skip},
+	})
+	r.suite.Skipped++
+}
+
+// Summary is the outcome of all the tests of a run.
+type Summary struct {
+	// Failures are the errors of the tests which failed, InfraErrors the ones
+	// of the tests which could not run because of their environment.
+	Failures    []error
+	InfraErrors []error
+	// Skipped is the number of skipped tests.
+	Skipped int
+	// Flaky are the tests which passed after being retried.
+	Flaky []string
+	// FailedTests are the names of the tests which failed, whatever the
+	// reason, for RerunFailed.
+	FailedTests []string
+	// Interrupted is set when the run was interrupted before its end.
+	Interrupted bool
+
+	// color colors the mismatches in the errors.
+	color bool
+}
+
+func (s *Summary) add(t testTask) {
+	switch {
+	case t.skip != "":
+		log.Warnf("skip test [%s]: %s", t.test, t.skip)
+		s.Skipped++
+	case t.err != nil:
+		msg := t.err.Error()
+		if s.color {
+			msg = coloredMessage(t.err)
+		}
+		e := fmt.Errorf("run test [%s] err: %s", t.test, msg)
+		if t.owner != "" {
+			e = fmt.Errorf("run test [%s] (owner: %s) err: %s", t.test, t.owner, msg)
+		}
+		log.Errorln(e)
+		if isInfraError(t.err) {
+			s.InfraErrors = append(s.InfraErrors, e)
+		} else {
+			s.Failures = append(s.Failures, e)
+		}
+		s.FailedTests = append(s.FailedTests, t.test)
+	case len(t.flaky) > 0:
+		log.Warnf("run test [%s] flaky, passed after %d failed attempts", t.test, len(t.flaky))
+		s.Flaky = append(s.Flaky, t.test)
+	default:
+		log.Infof("run test [%s] ok", t.test)
+	}
+}
+
+// Failed reports whether a test failed or could not run.
+func (s *Summary) Failed() bool {
+	return len(s.Failures) != 0 || len(s.InfraErrors) != 0
+}
+
+// Print logs the summary of the run.
+func (s *Summary) Print() {
+	if s.Skipped != 0 {
+		log.Warnf("%d tests skipped\n", s.Skipped)
+	}
+	if len(s.Flaky) != 0 {
+		log.Warnf("%d tests flaky, they passed after a retry: %s\n", len(s.Flaky), strings.Join(s.Flaky, ", "))
+	}
+	if len(s.Failures) != 0 {
+		log.Errorf("%d tests failed\n", len(s.Failures))
+		for _, item := range s.Failures {
+			log.Errorln(item)
+		}
+	}
+	if len(s.InfraErrors) != 0 {
+		log.Errorf("%d tests could not run because of infrastructure errors\n", len(s.InfraErrors))
+		for _, item := range s.InfraErrors {
+			log.Errorln(item)
+		}
+	}
+}
+
+// writeFailedTests records the names of the failed tests in file, one per
+// line, replacing the list of the previous run.
+func writeFailedTests(file string, tests []string) error {
+	if file == "" {
+		return nil
+	}
+	var b strings.Builder
+	for _, name := range tests {
+		b.WriteString(name)
+		b.WriteString("\n")
+	}
+	return errors.Trace(writeFileAtomic(file, []byte(b.String()), 0644))
+}
+
+// loadFailedTests reads the list written by writeFailedTests.
+func loadFailedTests(file string) ([]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	defer f.Close()
+	var tests []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if name := strings.TrimSpace(s.Text()); name != "" {
+			tests = append(tests, name)
+		}
+	}
+	return tests, errors.Trace(s.Err())
+}
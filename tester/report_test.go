@@ -0,0 +1,87 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsInfraError(t *testing.T) {
+	err := newInfraError(errors.New("dial tcp: connection refused"))
+	require.True(t, isInfraError(err))
+	require.True(t, isInfraError(errors.Trace(err)))
+	require.True(t, isInfraError(errors.Annotate(err, "Open db")))
+	require.False(t, isInfraError(errors.New("result mismatch")))
+	require.False(t, isInfraError(nil))
+
+	r := testRunner()
+	tr := r.NewTester("a")
+	now := time.Now()
+	tr.addFailure(&now, &err, 0)
+	failure := errors.New("result mismatch")
+	tr.addFailure(&now, &failure, 3)
+	suite := r.suite
+	require.Equal(t, 1, suite.Errors)
+	require.Equal(t, 1, suite.Failures)
+	require.Equal(t, "dial tcp: connection refused", suite.TestCases[0].Error)
+	require.Equal(t, "result mismatch", suite.TestCases[1].Failure)
+}
+
+func TestRunSummary(t *testing.T) {
+	summary := &Summary{}
+	summary.add(testTask{test: "a"})
+	summary.add(testTask{test: "b", err: errors.New("result mismatch")})
+	summary.add(testTask{test: "c", err: newInfraError(errors.New("connection refused"))})
+	summary.add(testTask{test: "d", skip: "broken"})
+	summary.add(testTask{test: "e", flaky: []error{errors.New("deadlock")}})
+	require.True(t, summary.Failed())
+	require.Equal(t, []string{"b", "c"}, summary.FailedTests)
+	require.Equal(t, []string{"e"}, summary.Flaky)
+	require.Equal(t, 1, summary.Skipped)
+
+	file := filepath.Join(t.TempDir(), "failed")
+	require.NoError(t, writeFailedTests(file, summary.FailedTests))
+	tests, err := loadFailedTests(file)
+	require.NoError(t, err)
+	require.Equal(t, []string{"b", "c"}, tests)
+	require.NoError(t, writeFailedTests(file, nil))
+	tests, err = loadFailedTests(file)
+	require.NoError(t, err)
+	require.Empty(t, tests)
+}
+
+func TestFlakyReport(t *testing.T) {
+	r := testRunner()
+	tr := r.NewTester("a")
+	tr.flaky = []error{errors.New("deadlock")}
+	now := time.Now()
+	tr.addSuccess(&now, 2)
+	suite := r.suite
+	require.Equal(t, 1, suite.Flaky)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, suite))
+	require.Contains(t, buf.String(), `<flakyFailure message="deadlock"></flakyFailure>`)
+}
+
+// testRunner returns a runner with the default options.
+func testRunner() *Runner {
+	return NewRunner(DefaultOptions())
+}
@@ -0,0 +1,331 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Runner runs tests with the same options and collects their outcome in
+// a Summary and in the XUnit report.
+type Runner struct {
+	opts Options
+
+	msgs chan testTask
+
+	// suite is the XUnit report of the tests run so far.
+	suiteLock   sync.Mutex
+	suite       XUnitTestSuite
+	report      reportState
+	recordStats recordStats
+
+	// suiteFiles caches the suite files by directory, relative to t, nil
+	// for the directories without one.
+	suitesLock sync.Mutex
+	suiteFiles map[string]*suiteFile
+	// pendingTests are the numbers of scheduled tests which did not finish
+	// yet by directory, see testDone.
+	pendingTests map[string]int
+
+	// dialects are the dialects of the servers by address, detected when
+	// the run starts, see detectServerDialect.
+	dialectLock sync.Mutex
+	dialects    map[string]Dialect
+
+	// leftSchemas are the schemas left by the tests of a parallel run by
+	// server, see leaveSchema. running are the numbers of running tests by
+	// server, see concurrentTests.
+	schemasLock sync.Mutex
+	leftSchemas map[string]*leftSchemas
+	running     map[string]int
+
+	// runID suffixes the schemas of the tests, so that the runs sharing a
+	// server do not use the same ones, see testSchemaName.
+	runID string
+
+	// interrupted is set by Interrupt: the running tests stop after their
+	// current statement, dropping their schema, and no other test starts.
+	interrupted atomic.Bool
+}
+
+// NewRunner returns a runner of tests with opts.
+func NewRunner(opts Options) *Runner {
+	return &Runner{
+		opts:        opts,
+		msgs:        make(chan testTask),
+		suiteFiles:  make(map[string]*suiteFile),
+		dialects:    make(map[string]Dialect),
+		leftSchemas: make(map[string]*leftSchemas),
+		running:     make(map[string]int),
+		runID:       strconv.FormatInt(rand.Int63n(36*36*36*36*36*36), 36),
+		suite: XUnitTestSuite{
+			Properties: make([]XUnitProperty, 0),
+			TestCases:  make([]XUnitTestCase, 0),
+		},
+	}
+}
+
+// Options returns the options of the runner.
+func (r *Runner) Options() Options {
+	return r.opts
+}
+
+// Cleanup tears down the suite fixtures set up by the tests run so far and
+// drops the schemas they left. Run does it once its tests are done, callers
+// of RunTest do it once they ran theirs.
+func (r *Runner) Cleanup() {
+	r.teardownFixtures()
+	r.dropLeftSchemas()
+}
+
+// Interrupt stops the run: the running tests stop after their current
+// statement and drop their schema, the other tests are reported as skipped.
+func (r *Runner) Interrupt() {
+	r.interrupted.Store(true)
+}
+
+// Interrupted reports whether Interrupt was called.
+func (r *Runner) Interrupted() bool {
+	return r.interrupted.Load()
+}
+
+type testTask struct {
+	err   error
+	test  string
+	owner string
+	// skip is the reason the test was skipped, empty if it ran.
+	skip string
+	// flaky holds the errors of the failed attempts of a test which passed
+	// after being retried.
+	flaky []error
+}
+
+// loadTestMetas reads the metadata of tests. Tests whose header can not be
+// parsed are returned as failed tasks and left out.
+func (r *Runner) loadTestMetas(tests []string) ([]string, map[string]*testMeta, []testTask) {
+	metas := make(map[string]*testMeta, len(tests))
+	valid := make([]string, 0, len(tests))
+	var failed []testTask
+	for _, name := range tests {
+		meta, err := loadTestMeta(r.opts.testFileName(name))
+		if err != nil {
+			failed = append(failed, testTask{test: name, err: errors.Annotate(err, "invalid test metadata")})
+			continue
+		}
+		metas[name] = meta
+		valid = append(valid, name)
+	}
+	return valid, metas, failed
+}
+
+// executeTests runs the tests, Parallel of them at the same time, in the
+// order given by their metadata.
+func (r *Runner) executeTests(tests []string, metas map[string]*testMeta) error {
+	nodes, err := buildTestGraph(tests, metas)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	r.scheduleFixtures(nodes)
+	runTestGraph(nodes, r.opts.Parallel, func(name string) {
+		defer r.testDone(name)
+		if r.Interrupted() {
+			task := testTask{test: name, skip: errInterrupted.Error()}
+			r.recordSkippedTest(task)
+			r.msgs <- task
+			return
+		}
+		tr := r.NewTester(name)
+		tr.meta = metas[name]
+		err := tr.Run()
+		if reason, ok := skipReason(err); ok {
+			r.msgs <- testTask{test: name, skip: reason, owner: tr.meta.owner}
+			return
+		}
+		r.msgs <- testTask{
+			test:  name,
+			err:   err,
+			owner: tr.meta.owner,
+			flaky: tr.flaky,
+		}
+	})
+	return nil
+}
+
+// RunTest runs the single test name, with its metadata, and returns its
+// error. Its outcome is recorded in the XUnit report but not flushed.
+func (r *Runner) RunTest(name string) error {
+	meta, err := loadTestMeta(r.opts.testFileName(name))
+	if err != nil {
+		return errors.Annotate(err, "invalid test metadata")
+	}
+	tr := r.NewTester(name)
+	tr.meta = meta
+	return tr.Run()
+}
+
+// consumeError collects the outcome of the tests.
+func (r *Runner) consumeError() *Summary {
+	summary := &Summary{color: r.opts.Color}
+	for {
+		if t, more := <-r.msgs; more {
+			summary.add(t)
+			r.FlushReport()
+		} else {
+			return summary
+		}
+	}
+}
+
+// selectTests returns the tests to run among args, test names or globs,
+// as told by the options, their metadata and the tests which will not run:
+// skipped ones and the ones whose metadata is invalid.
+func (r *Runner) selectTests(args []string) ([]string, map[string]*testMeta, []testTask, []testTask, error) {
+	tests, err := r.opts.expandTests(args)
+	if err != nil {
+		return nil, nil, nil, nil, errors.Annotate(err, "select tests")
+	}
+	runRe, err := compileOptionalRegex("run", r.opts.Run)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	excludeRe, err := compileOptionalRegex("exclude", r.opts.Exclude)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	tests = filterTestsByRegex(tests, runRe, excludeRe)
+	testShard, err := parseShard(r.opts.Shard)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	var durations map[string]time.Duration
+	if r.opts.ShardDurations != "" {
+		if durations, err = loadTestDurations(r.opts.ShardDurations); err != nil {
+			return nil, nil, nil, nil, errors.Annotate(err, "load test durations")
+		}
+	}
+
+	tests, metas, invalidTests := r.loadTestMetas(tests)
+	tests = filterTestsByTags(tests, metas, r.opts.IncludeTags, r.opts.ExcludeTags)
+	var skippedTests []testTask
+	if r.opts.SkipFile != "" {
+		entries, err := loadSkipFile(r.opts.SkipFile)
+		if err != nil {
+			return nil, nil, nil, nil, errors.Annotate(err, "load skip file")
+		}
+		tests, skippedTests = applySkipList(tests, entries, time.Now())
+	}
+	// Tests which will not run are dealt separately, so that they do not
+	// unbalance the shards.
+	tests = shardTests(tests, testShard, durations)
+	skippedTests = shardTasks(skippedTests, testShard)
+	invalidTests = shardTasks(invalidTests, testShard)
+	return tests, metas, skippedTests, invalidTests, nil
+}
+
+// Run runs the tests named by args, test names or globs, all the tests if
+// there is none, or the ones which failed in the last run with
+// RerunFailed. An error means the run could not start, the outcome of the
+// tests is in the summary.
+func (r *Runner) Run(args []string) (*Summary, error) {
+	startTime := time.Now()
+	if r.opts.XUnitFile != "" {
+		// a run failing before the tests start must not leave the report of
+		// the previous one
+		if err := os.Remove(r.opts.XUnitFile); err != nil && !os.IsNotExist(err) {
+			return nil, errors.Annotate(err, "drop previous xunit file")
+		}
+	}
+
+	if r.opts.Reference != nil && r.opts.recording() {
+		return nil, errors.New("the output of a differential run can not be recorded")
+	}
+
+	if r.opts.RerunFailed {
+		if len(args) > 0 {
+			return nil, errors.New("RerunFailed does not take test names")
+		}
+		failed, err := loadFailedTests(r.opts.FailedFile)
+		if err != nil {
+			return nil, errors.Annotate(err, "load failed tests")
+		}
+		if len(failed) == 0 {
+			log.Warn("no failed test to rerun")
+			return &Summary{}, nil
+		}
+		args = failed
+	}
+
+	tests, metas, skippedTests, invalidTests, err := r.selectTests(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(tests) > 0 {
+		if _, err = r.detectServerDialect(&r.opts); err != nil {
+			return nil, err
+		}
+		if r.opts.Reference != nil {
+			if _, err = r.detectServerDialect(r.opts.Reference); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if !r.opts.recording() {
+		log.Infof("running tests: %v", tests)
+	} else {
+		log.Infof("recording tests: %v", tests)
+	}
+
+	if !r.opts.CheckErr {
+		log.Warn("--check-error is not set! --error in .test file will simply accept zero or more errors! (i.e. not even check for errors!)")
+	}
+	r.initReport(len(tests)+len(invalidTests)+len(skippedTests), startTime)
+	go func() {
+		for _, t := range skippedTests {
+			r.recordSkippedTest(t)
+			r.msgs <- t
+		}
+		for _, t := range invalidTests {
+			r.recordInvalidTest(t)
+			r.msgs <- t
+		}
+		if err := r.executeTests(tests, metas); err != nil {
+			r.msgs <- testTask{test: strings.Join(tests, ","), err: newInfraError(err)}
+		}
+		close(r.msgs)
+	}()
+
+	summary := r.consumeError()
+	r.Cleanup()
+	summary.Interrupted = r.Interrupted()
+	r.FlushReport()
+	if r.opts.recording() {
+		r.printRecordSummary()
+	}
+	if r.opts.FailedFile != "" {
+		if err := writeFailedTests(r.opts.FailedFile, summary.FailedTests); err != nil {
+			log.Errorf("write failed tests err %v", err)
+		}
+	}
+	return summary, nil
+}
@@ -0,0 +1,297 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// testNode is a selected test in the dependency graph.
+type testNode struct {
+	name string
+	// order is the position of the test in the selection.
+	order int
+	// pending is the number of dependencies which have not finished yet.
+	pending int
+	// dependents are the tests waiting for this one.
+	dependents []*testNode
+	// serial tests run while no other test is running.
+	serial bool
+	// slow tests start before the other ready tests.
+	slow bool
+}
+
+// buildTestGraph orders tests by the dependencies in their metadata: the
+// @depends list, and every test tagged first for the ones which are not. A
+// dependency which is not selected is ignored, a failed dependency does not
+// prevent its dependents from running. The returned nodes keep the order of
+// tests.
+func buildTestGraph(tests []string, metas map[string]*testMeta) ([]*testNode, error) {
+	nodes := make([]*testNode, 0, len(tests))
+	byName := make(map[string]*testNode, len(tests))
+	for _, name := range tests {
+		if _, ok := byName[name]; ok {
+			continue
+		}
+		meta := metas[name]
+		n := &testNode{name: name, order: len(nodes), serial: meta.hasTag(tagSerial), slow: meta.hasTag(tagSlow)}
+		nodes = append(nodes, n)
+		byName[name] = n
+	}
+	var first []string
+	for _, n := range nodes {
+		if metas[n.name].hasTag(tagFirst) {
+			first = append(first, n.name)
+		}
+	}
+	for _, n := range nodes {
+		deps := metas[n.name].dependencies()
+		if !metas[n.name].hasTag(tagFirst) {
+			deps = append(append([]string(nil), first...), deps...)
+		}
+		seen := make(map[*testNode]struct{}, len(deps))
+		for _, dep := range deps {
+			d, ok := byName[dep]
+			if !ok || d == n {
+				continue
+			}
+			if _, ok := seen[d]; ok {
+				continue
+			}
+			seen[d] = struct{}{}
+			d.dependents = append(d.dependents, n)
+			n.pending++
+		}
+	}
+	if cycle := findCycle(nodes); len(cycle) > 0 {
+		return nil, errors.Errorf("tests depend on each other: %s", strings.Join(cycle, ", "))
+	}
+	return nodes, nil
+}
+
+// findCycle returns the names of the tests which can never run because
+// they depend on each other, sorted, or nil.
+func findCycle(nodes []*testNode) []string {
+	pending := make(map[*testNode]int, len(nodes))
+	var ready []*testNode
+	for _, n := range nodes {
+		pending[n] = n.pending
+		if n.pending == 0 {
+			ready = append(ready, n)
+		}
+	}
+	for len(ready) > 0 {
+		n := ready[0]
+		ready = ready[1:]
+		for _, d := range n.dependents {
+			pending[d]--
+			if pending[d] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+	var cycle []string
+	for n, p := range pending {
+		if p > 0 {
+			cycle = append(cycle, n.name)
+		}
+	}
+	sort.Strings(cycle)
+	return cycle
+}
+
+// runTestGraph runs the tests with at most parallel of them at the same time.
+// A test starts once all its dependencies finished, ready tests start slow
+// ones first, then in the order of nodes. A serial test waits for the
+// running tests to finish and runs alone.
+func runTestGraph(nodes []*testNode, parallel int, run func(name string)) {
+	if parallel < 1 {
+		parallel = 1
+	}
+	var ready []*testNode
+	for _, n := range nodes {
+		if n.pending == 0 {
+			ready = insertReady(ready, n)
+		}
+	}
+	done := make(chan *testNode)
+	running, runningSerial := 0, false
+	for finished := 0; finished < len(nodes); finished++ {
+		for running < parallel && len(ready) > 0 && !runningSerial {
+			n := ready[0]
+			if n.serial && running > 0 {
+				break
+			}
+			ready = ready[1:]
+			running++
+			runningSerial = n.serial
+			go func(n *testNode) {
+				run(n.name)
+				done <- n
+			}(n)
+		}
+		n := <-done
+		running--
+		if n.serial {
+			runningSerial = false
+		}
+		for _, d := range n.dependents {
+			d.pending--
+			if d.pending == 0 {
+				ready = insertReady(ready, d)
+			}
+		}
+	}
+}
+
+// insertReady inserts n into ready, which is ordered slow tests first, then
+// by selection order.
+func insertReady(ready []*testNode, n *testNode) []*testNode {
+	i := sort.Search(len(ready), func(i int) bool {
+		if ready[i].slow != n.slow {
+			return n.slow
+		}
+		return ready[i].order > n.order
+	})
+	ready = append(ready, nil)
+	copy(ready[i+1:], ready[i:])
+	ready[i] = n
+	return ready
+}
+
+// activeSchemas holds the schemas of the running tests by server, so that a
+// test cleaning up does not drop the schema of another one.
+var activeSchemas = struct {
+	sync.Mutex
+	names map[string]struct{}
+}{names: make(map[string]struct{})}
+
+// acquireSchema registers name as used by a running test on server.
+func acquireSchema(server, name string) error {
+	activeSchemas.Lock()
+	defer activeSchemas.Unlock()
+	key := server + "/" + name
+	if _, ok := activeSchemas.names[key]; ok {
+		return errors.Errorf("schema %s is already used by another running test on %s", name, server)
+	}
+	activeSchemas.names[key] = struct{}{}
+	return nil
+}
+
+func releaseSchema(server, name string) {
+	activeSchemas.Lock()
+	defer activeSchemas.Unlock()
+	delete(activeSchemas.names, server+"/"+name)
+}
+
+func isActiveSchema(server, name string) bool {
+	activeSchemas.Lock()
+	defer activeSchemas.Unlock()
+	_, ok := activeSchemas.names[server+"/"+name]
+	return ok
+}
+
+// leftSchemas are the schemas created by the tests of a parallel run on a
+// server besides their own, dropped once the run is done: another running
+// test may still use them.
+type leftSchemas struct {
+	opts  Options
+	names map[string]struct{}
+}
+
+// leaveSchema defers the drop of name, created by a test on the server of
+// opts, to the end of the run.
+func (r *Runner) leaveSchema(opts *Options, name string) {
+	r.schemasLock.Lock()
+	defer r.schemasLock.Unlock()
+	left, ok := r.leftSchemas[opts.server()]
+	if !ok {
+		left = &leftSchemas{opts: *opts, names: make(map[string]struct{})}
+		r.leftSchemas[opts.server()] = left
+	}
+	left.names[name] = struct{}{}
+}
+
+// startTest counts a test running on server until its endTest.
+func (r *Runner) startTest(server string) {
+	r.schemasLock.Lock()
+	defer r.schemasLock.Unlock()
+	r.running[server]++
+}
+
+func (r *Runner) endTest(server string) {
+	r.schemasLock.Lock()
+	defer r.schemasLock.Unlock()
+	r.running[server]--
+}
+
+// concurrentTests reports whether other tests are running on server than
+// the calling one.
+func (r *Runner) concurrentTests(server string) bool {
+	r.schemasLock.Lock()
+	defer r.schemasLock.Unlock()
+	return r.running[server] > 1
+}
+
+// dropLeftSchemas drops the schemas left by the tests of a parallel run,
+// unless a test is running in them again. Its errors are only logged.
+func (r *Runner) dropLeftSchemas() {
+	r.schemasLock.Lock()
+	servers := r.leftSchemas
+	r.leftSchemas = make(map[string]*leftSchemas)
+	r.schemasLock.Unlock()
+	for server, left := range servers {
+		exec, closeConn, err := sideConn(&left.opts)
+		if err != nil {
+			log.Warnf("drop schemas left on %s err %v", server, err)
+			continue
+		}
+		for name := range left.names {
+			if isActiveSchema(server, name) {
+				continue
+			}
+			if err = exec(fmt.Sprintf("DROP DATABASE IF EXISTS `%s`", name)); err != nil {
+				log.Warnf("drop schema %s on %s err %v", name, server, err)
+			}
+		}
+		closeConn()
+	}
+}
+
+// sideConn opens a connection to the server of opts, outside of any test.
+// exec runs a statement on it within the statement timeout of opts.
+func sideConn(opts *Options) (exec func(stmt string) error, closeConn func(), err error) {
+	cm := newConnManager(opts)
+	conn, err := cm.AddConnection(default_connection, opts.Host, opts.User, opts.Password, "", false)
+	if err != nil {
+		return nil, nil, err
+	}
+	exec = func(stmt string) error {
+		ctx, cancel := runStmtContext(opts)
+		defer cancel()
+		_, err := conn.conn.ExecContext(ctx, stmt)
+		return err
+	}
+	closeConn = func() {
+		cm.CloseAllConnections()
+		conn.mdb.Close()
+	}
+	return exec, closeConn, nil
+}
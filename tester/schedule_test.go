@@ -0,0 +1,125 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunTestGraphOrder(t *testing.T) {
+	tests := []string{"a", "role2", "show", "b", "sub_query_more", "role", "infoschema"}
+	metas := map[string]*testMeta{
+		"role2":          {depends: []string{"role"}},
+		"show":           {tags: []string{tagFirst}},
+		"infoschema":     {tags: []string{tagFirst}},
+		"sub_query_more": {tags: []string{tagSlow}},
+	}
+	nodes, err := buildTestGraph(tests, metas)
+	require.NoError(t, err)
+
+	var order []string
+	runTestGraph(nodes, 1, func(name string) {
+		order = append(order, name)
+	})
+	require.Equal(t, []string{"show", "infoschema", "sub_query_more", "a", "b", "role", "role2"}, order)
+}
+
+func TestRunTestGraphParallel(t *testing.T) {
+	tests := []string{"show", "a", "b", "c", "role", "d", "e"}
+	metas := map[string]*testMeta{
+		"show": {tags: []string{tagFirst}},
+		"role": {tags: []string{tagSerial}},
+	}
+	nodes, err := buildTestGraph(tests, metas)
+	require.NoError(t, err)
+
+	var (
+		mu      sync.Mutex
+		running int
+		maxRun  int
+		started []string
+	)
+	runTestGraph(nodes, 3, func(name string) {
+		mu.Lock()
+		running++
+		if running > maxRun {
+			maxRun = running
+		}
+		started = append(started, name)
+		if name == "show" || name == "role" {
+			require.Equal(t, 1, running, name)
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+	})
+	require.Len(t, started, len(tests))
+	require.Equal(t, "show", started[0])
+	require.Equal(t, 3, maxRun)
+}
+
+func TestBuildTestGraphCycle(t *testing.T) {
+	metas := map[string]*testMeta{
+		"a": {depends: []string{"c"}},
+		"b": {depends: []string{"a"}},
+		"c": {depends: []string{"b"}},
+		"d": {depends: []string{"a", "missing"}},
+	}
+	_, err := buildTestGraph([]string{"a", "b", "c", "d"}, metas)
+	require.ErrorContains(t, err, "a, b, c, d")
+
+	nodes, err := buildTestGraph([]string{"d", "a", "a"}, metas)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+}
+
+func TestActiveSchemas(t *testing.T) {
+	require.NoError(t, acquireSchema("127.0.0.1:4000", "quickbi__interval"))
+	require.Error(t, acquireSchema("127.0.0.1:4000", "quickbi__interval"))
+	require.NoError(t, acquireSchema("127.0.0.1:3306", "quickbi__interval"))
+	require.True(t, isActiveSchema("127.0.0.1:4000", "quickbi__interval"))
+	releaseSchema("127.0.0.1:4000", "quickbi__interval")
+	require.False(t, isActiveSchema("127.0.0.1:4000", "quickbi__interval"))
+	require.True(t, isActiveSchema("127.0.0.1:3306", "quickbi__interval"))
+	releaseSchema("127.0.0.1:3306", "quickbi__interval")
+	require.Equal(t, "quickbi__interval", schemaName("quickbi/interval"))
+
+	// the schemas of a run have its ID, which the output does not show
+	r := testRunner()
+	require.Equal(t, "quickbi__interval_"+r.runID, r.testSchemaName("quickbi/interval"))
+	tr := r.NewTester("quickbi/interval")
+	tr.writeError(errors.New("Table '" + tr.dbName + ".t' doesn't exist"))
+	require.Equal(t, "Table 'quickbi__interval.t' doesn't exist\n", tr.buf.String())
+
+	// schemas created besides their own are dropped at the end of the run,
+	// when other tests run on the server
+	require.False(t, r.concurrentTests(r.opts.server()))
+	r.startTest(r.opts.server())
+	r.startTest(r.opts.server())
+	require.True(t, r.concurrentTests(r.opts.server()))
+	r.endTest(r.opts.server())
+	require.False(t, r.concurrentTests(r.opts.server()))
+	r.leaveSchema(&r.opts, "foo")
+	r.leaveSchema(&r.opts, "bar")
+	r.leaveSchema(&r.opts, "foo")
+	require.Len(t, r.leftSchemas, 1)
+	require.Len(t, r.leftSchemas[r.opts.server()].names, 2)
+}
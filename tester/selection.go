@@ -0,0 +1,207 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// skipDateLayout is the layout of the until date of a skip entry.
+const skipDateLayout = "2006-01-02"
+
+// normalizeTestName turns "t/quickbi/interval.test" into "quickbi/interval",
+// so that paths completed by the shell can be given as well.
+func normalizeTestName(name string) string {
+	name = strings.TrimPrefix(path.Clean(filepath.ToSlash(name)), "t/")
+	return strings.TrimSuffix(name, ".test")
+}
+
+func isGlob(name string) bool {
+	return strings.ContainsAny(name, "*?[")
+}
+
+// expandTests expands the test names given on the command line. A name
+// containing a glob meta character, e.g. "t/quickbi/*", is matched against
+// the tests found by LoadAllTests, other names are taken as they are. No
+// name selects every test. Duplicates are dropped.
+func (o *Options) expandTests(args []string) ([]string, error) {
+	var all []string
+	needAll := len(args) == 0
+	for _, arg := range args {
+		needAll = needAll || isGlob(arg)
+	}
+	if needAll {
+		var err error
+		if all, err = o.LoadAllTests(); err != nil {
+			return nil, errors.Annotate(err, "load all tests")
+		}
+	}
+	if len(args) == 0 {
+		return all, nil
+	}
+	return expandTestPatterns(args, all)
+}
+
+func expandTestPatterns(args []string, all []string) ([]string, error) {
+	var tests []string
+	seen := make(map[string]struct{})
+	add := func(name string) {
+		if _, ok := seen[name]; !ok {
+			seen[name] = struct{}{}
+			tests = append(tests, name)
+		}
+	}
+	for _, arg := range args {
+		pattern := normalizeTestName(arg)
+		if !isGlob(pattern) {
+			add(pattern)
+			continue
+		}
+		matched := false
+		for _, name := range all {
+			ok, err := path.Match(pattern, name)
+			if err != nil {
+				return nil, errors.Annotatef(err, "invalid test pattern %q", arg)
+			}
+			if ok {
+				matched = true
+				add(name)
+			}
+		}
+		if !matched {
+			return nil, errors.Errorf("no test matches %q", arg)
+		}
+	}
+	return tests, nil
+}
+
+// filterTestsByRegex keeps the tests matching run, if given, and not
+// matching exclude, if given.
+func filterTestsByRegex(tests []string, run, exclude *regexp.Regexp) []string {
+	ret := make([]string, 0, len(tests))
+	for _, name := range tests {
+		if run != nil && !run.MatchString(name) {
+			continue
+		}
+		if exclude != nil && exclude.MatchString(name) {
+			continue
+		}
+		ret = append(ret, name)
+	}
+	return ret
+}
+
+// compileOptionalRegex compiles s, an empty s gives nil.
+func compileOptionalRegex(flagName, s string) (*regexp.Regexp, error) {
+	if s == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(s)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid -%s", flagName)
+	}
+	return re, nil
+}
+
+// skipEntry is an item of the skip file:
+//
+//   - test: quickbi/interval
+//     reason: INTERVAL arithmetic is not supported yet
+//     until: 2025-12-31
+//
+// test may be a glob. The entry no longer applies after the until date.
+type skipEntry struct {
+	Test   string `yaml:"test"`
+	Reason string `yaml:"reason"`
+	Until  string `yaml:"until"`
+
+	until time.Time
+}
+
+// loadSkipFile reads the skip entries of file.
+func loadSkipFile(file string) ([]skipEntry, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var entries []skipEntry
+	if err = yaml.Unmarshal(data, &entries); err != nil {
+		return nil, errors.Annotatef(err, "parse skip file %s", file)
+	}
+	for i := range entries {
+		e := &entries[i]
+		if e.Test == "" || e.Reason == "" {
+			return nil, errors.Errorf("%s: entry %d must have a test and a reason", file, i+1)
+		}
+		e.Test = normalizeTestName(e.Test)
+		// path.Match only reports a bad pattern, whatever the name.
+		if _, err = path.Match(e.Test, ""); err != nil {
+			return nil, errors.Annotatef(err, "%s: invalid test pattern %q", file, e.Test)
+		}
+		if e.Until != "" {
+			if e.until, err = time.ParseInLocation(skipDateLayout, e.Until, time.Local); err != nil {
+				return nil, errors.Errorf("%s: entry %d: until must look like %s", file, i+1, skipDateLayout)
+			}
+		}
+	}
+	return entries, nil
+}
+
+// expired reports whether the entry no longer applies at now. The until day
+// itself is still skipped.
+func (e *skipEntry) expired(now time.Time) bool {
+	return !e.until.IsZero() && !now.Before(e.until.AddDate(0, 0, 1))
+}
+
+// applySkipList splits tests into the ones to run and the skipped ones, as
+// tasks carrying the reason. Expired entries are warned about and ignored.
+func applySkipList(tests []string, entries []skipEntry, now time.Time) ([]string, []testTask) {
+	var active []skipEntry
+	for _, e := range entries {
+		if e.expired(now) {
+			log.Warnf("skip entry of %s expired on %s, running it again", e.Test, e.Until)
+			continue
+		}
+		active = append(active, e)
+	}
+	run := make([]string, 0, len(tests))
+	var skipped []testTask
+	for _, name := range tests {
+		reason := ""
+		for _, e := range active {
+			if ok, _ := path.Match(e.Test, name); ok {
+				reason = e.Reason
+				if e.Until != "" {
+					reason += " (until " + e.Until + ")"
+				}
+				break
+			}
+		}
+		if reason == "" {
+			run = append(run, name)
+		} else {
+			skipped = append(skipped, testTask{test: name, skip: reason})
+		}
+	}
+	return run, skipped
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandTestPatterns(t *testing.T) {
+	all := []string{"example", "quickbi/interval", "quickbi/date", "quickbi/sub/x"}
+	tests, err := expandTestPatterns([]string{"t/quickbi/*", "example", "quickbi/date", "t/example.test"}, all)
+	require.NoError(t, err)
+	require.Equal(t, []string{"quickbi/interval", "quickbi/date", "example"}, tests)
+
+	_, err = expandTestPatterns([]string{"nothing/*"}, all)
+	require.Error(t, err)
+
+	tests = filterTestsByRegex(all, regexp.MustCompile("^quickbi/"), regexp.MustCompile("sub"))
+	require.Equal(t, []string{"quickbi/interval", "quickbi/date"}, tests)
+	require.Equal(t, all, filterTestsByRegex(all, nil, nil))
+}
+
+func TestLoadAllTests(t *testing.T) {
+	opts := DefaultOptions()
+	opts.Dir = t.TempDir()
+	for _, name := range []string{"example.test", "quickbi/interval.test", "sub/collation_c.test", "notes.txt"} {
+		p := filepath.Join(opts.Dir, "t", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, nil, 0644))
+	}
+	tests, err := opts.LoadAllTests()
+	require.NoError(t, err)
+	require.Equal(t, []string{"example", "quickbi/interval", "sub/collation_c"}, tests)
+
+	opts.CollationDisable = true
+	tests, err = opts.expandTests([]string{"t/*/*"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"sub/collation_c"}, tests)
+}
+
+func TestSkipFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "skip.yaml")
+	require.NoError(t, os.WriteFile(file, []byte(`
+- test: quickbi/interval
+  reason: INTERVAL is not supported yet
+- test: t/quickbi/date*
+  reason: date functions are broken
+  until: 2025-03-01
+- test: example
+  reason: fixed
+  until: 2025-01-01
+`), 0644))
+	entries, err := loadSkipFile(file)
+	require.NoError(t, err)
+	require.Equal(t, "quickbi/date*", entries[1].Test)
+
+	now := time.Date(2025, 3, 1, 23, 0, 0, 0, time.Local)
+	run, skipped := applySkipList([]string{"example", "quickbi/interval", "quickbi/date_add", "other"}, entries, now)
+	require.Equal(t, []string{"example", "other"}, run)
+	require.Len(t, skipped, 2)
+	require.Equal(t, "quickbi/date_add", skipped[1].test)
+	require.Equal(t, "date functions are broken (until 2025-03-01)", skipped[1].skip)
+
+	run, _ = applySkipList([]string{"quickbi/date_add"}, entries, now.Add(time.Hour))
+	require.Equal(t, []string{"quickbi/date_add"}, run)
+
+	require.NoError(t, os.WriteFile(file, []byte("- test: a\n"), 0644))
+	_, err = loadSkipFile(file)
+	require.Error(t, err)
+	require.NoError(t, os.WriteFile(file, []byte("- test: a\n  reason: b\n  until: tomorrow\n"), 0644))
+	_, err = loadSkipFile(file)
+	require.Error(t, err)
+}
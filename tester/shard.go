@@ -0,0 +1,152 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"encoding/xml"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+)
+
+// shardSpec is the Shard option, i/n, the i-th of n jobs, counting from 1.
+type shardSpec struct {
+	index int
+	total int
+}
+
+// parseShard parses "i/n". An empty s means no sharding.
+func parseShard(s string) (shardSpec, error) {
+	if s == "" {
+		return shardSpec{index: 1, total: 1}, nil
+	}
+	i, n, ok := strings.Cut(s, "/")
+	index, err1 := strconv.Atoi(strings.TrimSpace(i))
+	total, err2 := strconv.Atoi(strings.TrimSpace(n))
+	if !ok || err1 != nil || err2 != nil || total < 1 || index < 1 || index > total {
+		return shardSpec{}, errors.Errorf("invalid -shard %q, it must look like i/n with 1 <= i <= n", s)
+	}
+	return shardSpec{index: index, total: total}, nil
+}
+
+// shardTests returns the tests of the shard, in their original order. The
+// partition only depends on the names of the tests and on durations, so that
+// every job computes the same one. Without durations, the tests sorted by
+// name are dealt in turn to the shards. With durations, the longest tests
+// are assigned first, each to the shard with the least total duration so far,
+// or with the fewest tests among equal ones; a test missing from durations
+// counts as the average of the known ones. Durations known for none of the
+// tests are ignored.
+func shardTests(tests []string, shard shardSpec, durations map[string]time.Duration) []string {
+	if shard.total <= 1 {
+		return tests
+	}
+	sorted := append([]string(nil), tests...)
+	sort.Strings(sorted)
+	var known time.Duration
+	cnt := 0
+	for _, name := range sorted {
+		if d, ok := durations[name]; ok {
+			known += d
+			cnt++
+		}
+	}
+	owner := make(map[string]int, len(sorted))
+	if known == 0 {
+		for i, name := range sorted {
+			owner[name] = i % shard.total
+		}
+	} else {
+		avg := known / time.Duration(cnt)
+		cost := func(name string) time.Duration {
+			if d, ok := durations[name]; ok {
+				return d
+			}
+			return avg
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return cost(sorted[i]) > cost(sorted[j])
+		})
+		loads := make([]time.Duration, shard.total)
+		counts := make([]int, shard.total)
+		for _, name := range sorted {
+			least := 0
+			for i := range loads {
+				if loads[i] < loads[least] || (loads[i] == loads[least] && counts[i] < counts[least]) {
+					least = i
+				}
+			}
+			owner[name] = least
+			loads[least] += cost(name)
+			counts[least]++
+		}
+	}
+	ret := make([]string, 0, len(tests)/shard.total+1)
+	for _, name := range tests {
+		if owner[name] == shard.index-1 {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+// shardTasks keeps the tasks of the tests of the shard.
+func shardTasks(tasks []testTask, shard shardSpec) []testTask {
+	names := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		names = append(names, task.test)
+	}
+	keep := make(map[string]struct{}, len(names))
+	for _, name := range shardTests(names, shard, nil) {
+		keep[name] = struct{}{}
+	}
+	ret := tasks[:0]
+	for _, task := range tasks {
+		if _, ok := keep[task.test]; ok {
+			ret = append(ret, task)
+		}
+	}
+	return ret
+}
+
+// loadTestDurations reads the duration of every test which passed or failed
+// in an XUnit report written with XUnitFile.
+func loadTestDurations(file string) (map[string]time.Duration, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var suites XUnitTestSuites
+	if err = xml.Unmarshal(data, &suites); err != nil {
+		return nil, errors.Annotatef(err, "parse report %s", file)
+	}
+	durations := make(map[string]time.Duration)
+	for _, suite := range suites.Suites {
+		for _, tc := range suite.TestCases {
+			if tc.Time == "" {
+				continue
+			}
+			d, err := time.ParseDuration(tc.Time)
+			if err != nil {
+				return nil, errors.Annotatef(err, "%s: invalid time of %s", file, tc.Name)
+			}
+			durations[normalizeTestName(tc.Name)] = d
+		}
+	}
+	return durations, nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseShard(t *testing.T) {
+	s, err := parseShard("2/4")
+	require.NoError(t, err)
+	require.Equal(t, shardSpec{index: 2, total: 4}, s)
+	s, err = parseShard("")
+	require.NoError(t, err)
+	require.Equal(t, 1, s.total)
+	for _, bad := range []string{"0/2", "3/2", "1", "a/b", "1/0"} {
+		_, err = parseShard(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestShardTests(t *testing.T) {
+	tests := []string{"e", "b", "a", "d", "c"}
+	var all []string
+	for i := 1; i <= 2; i++ {
+		all = append(all, shardTests(tests, shardSpec{index: i, total: 2}, nil)...)
+	}
+	require.ElementsMatch(t, tests, all)
+	require.Equal(t, []string{"e", "a", "c"}, shardTests(tests, shardSpec{index: 1, total: 2}, nil))
+
+	durations := map[string]time.Duration{
+		"a": 10 * time.Second,
+		"b": 6 * time.Second,
+		"c": 3 * time.Second,
+		"d": 1 * time.Second,
+	}
+	// e counts as the average, 5s: a c (13s) | b e d (12s)
+	require.Equal(t, []string{"a", "c"}, shardTests(tests, shardSpec{index: 1, total: 2}, durations))
+	require.Equal(t, []string{"e", "b", "d"}, shardTests(tests, shardSpec{index: 2, total: 2}, durations))
+
+	// durations of other tests only are ignored
+	durations = map[string]time.Duration{"renamed": time.Second, "b": 0}
+	for i := 1; i <= 2; i++ {
+		require.Equal(t, shardTests(tests, shardSpec{index: i, total: 2}, nil), shardTests(tests, shardSpec{index: i, total: 2}, durations))
+	}
+	// tests without duration are spread among the shards of equal loads
+	durations = map[string]time.Duration{"a": 0, "b": 0, "c": 0, "d": 0, "e": time.Second}
+	require.Len(t, shardTests(tests, shardSpec{index: 1, total: 3}, durations), 1)
+	require.Len(t, shardTests(tests, shardSpec{index: 2, total: 3}, durations), 2)
+	require.Len(t, shardTests(tests, shardSpec{index: 3, total: 3}, durations), 2)
+}
+
+func TestLoadTestDurations(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, XUnitTestSuite{TestCases: []XUnitTestCase{
+		{Name: reportTestName("quickbi/interval"), Time: "1.500000s"},
+		{Name: reportTestName("example"), Time: "0.250000s", Failure: "mismatch"},
+		{Name: reportTestName("skipped"), Skipped: &XUnitSkipped{Message: "broken"}},
+	}}))
+	file := filepath.Join(t.TempDir(), "report.xml")
+	require.NoError(t, os.WriteFile(file, buf.Bytes(), 0644))
+	durations, err := loadTestDurations(file)
+	require.NoError(t, err)
+	require.Equal(t, map[string]time.Duration{
+		"quickbi/interval": 1500 * time.Millisecond,
+		"example":          250 * time.Millisecond,
+	}, durations)
+}
@@ -0,0 +1,325 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+)
+
+// suiteFileName is the file configuring the tests of a directory of t and
+// of its subdirectories.
+const suiteFileName = "suite.yaml"
+
+// suiteFile is a suite.yaml file:
+//
+//	options:
+//	  time_zone: UTC
+//	  check_error: true
+//	init_sql:
+//	  - SET sql_mode = ''
+//	replace_regex:
+//	  - /[0-9]{4}-[0-9]{2}-[0-9]{2}/<DATE>/
+//	setup: [setup.sql]
+//	teardown: [teardown.sql]
+//	fixture:
+//	  setup: [inter2.sql]
+//
+// Setup and teardown files are relative to the directory of the file. They
+// run for each test in its schema, while the fixture runs once for all the
+// tests of the suite, see suiteFixture.
+type suiteFile struct {
+	Options      suiteOptions  `yaml:"options"`
+	InitSQL      []string      `yaml:"init_sql"`
+	ReplaceRegex []string      `yaml:"replace_regex"`
+	Setup        []string      `yaml:"setup"`
+	Teardown     []string      `yaml:"teardown"`
+	Fixture      *suiteFixture `yaml:"fixture"`
+
+	// dir is the directory of the file, suite the same relative to t.
+	dir          string
+	suite        string
+	replaceRegex []*ReplaceRegex
+}
+
+// suiteOptions are the options a suite sets for its tests.
+type suiteOptions struct {
+	TimeZone      *string           `yaml:"time_zone"`
+	Params        map[string]string `yaml:"params"`
+	CheckErr      *bool             `yaml:"check_error"`
+	StmtTimeout   *time.Duration    `yaml:"stmt_timeout"`
+	TestTimeout   *time.Duration    `yaml:"test_timeout"`
+	Retries       *int              `yaml:"retries"`
+	AllMismatches *bool             `yaml:"all_mismatches"`
+	DiffContext   *int              `yaml:"diff_context"`
+}
+
+// suiteConfig is the configuration of a test, merged from the suite files
+// of its directory and of the directories above it, up to t.
+type suiteConfig struct {
+	// files are the suite files, outermost first.
+	files []*suiteFile
+}
+
+// loadSuiteFile reads the suite file of dir, nil if there is none.
+func loadSuiteFile(dir string) (*suiteFile, error) {
+	file := filepath.Join(dir, suiteFileName)
+	data, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sf := &suiteFile{dir: dir}
+	if err = yaml.Unmarshal(data, sf); err != nil {
+		return nil, errors.Annotatef(err, "parse suite file %s", file)
+	}
+	for _, rule := range sf.ReplaceRegex {
+		regex, err := ParseReplaceRegex(rule)
+		if err != nil {
+			return nil, errors.Annotatef(err, "%s: invalid replace_regex %s", file, rule)
+		}
+		sf.replaceRegex = append(sf.replaceRegex, regex...)
+	}
+	return sf, nil
+}
+
+// suiteOf returns the configuration of the test name. Suite files are read
+// once per run.
+func (r *Runner) suiteOf(name string) (*suiteConfig, error) {
+	sc := &suiteConfig{}
+	for _, dir := range suiteDirs(name) {
+		sf, err := r.suiteFileOf(dir)
+		if err != nil {
+			return nil, err
+		}
+		if sf != nil {
+			sc.files = append(sc.files, sf)
+		}
+	}
+	return sc, nil
+}
+
+// suiteDirs returns the directories whose suite file applies to the test
+// name, relative to the t directory, the outermost first.
+func suiteDirs(name string) []string {
+	dirs := []string{""}
+	if dir := path.Dir(name); dir != "." {
+		parts := strings.Split(dir, "/")
+		for i := range parts {
+			dirs = append(dirs, strings.Join(parts[:i+1], "/"))
+		}
+	}
+	return dirs
+}
+
+// suiteFileOf returns the suite file of dir, relative to the t directory.
+func (r *Runner) suiteFileOf(dir string) (*suiteFile, error) {
+	r.suitesLock.Lock()
+	defer r.suitesLock.Unlock()
+	if sf, ok := r.suiteFiles[dir]; ok {
+		return sf, nil
+	}
+	sf, err := loadSuiteFile(filepath.Join(r.opts.Dir, "t", filepath.FromSlash(dir)))
+	if err != nil {
+		return nil, err
+	}
+	if sf != nil {
+		sf.suite = dir
+	}
+	r.suiteFiles[dir] = sf
+	return sf, nil
+}
+
+// apply sets the options of the suite files to opts, the inner files
+// overriding the outer ones, then the ones of the given flags again, since
+// the suite files only change the defaults. Parameters and init statements
+// add up, the ones of the suites run after the default init SQL of the
+// server.
+func (sc *suiteConfig) apply(opts *Options) {
+	given := *opts
+	opts.InitSQL = append([]string{}, opts.initSQL()...)
+	for _, sf := range sc.files {
+		o := sf.Options
+		setIf(&opts.TimeZone, o.TimeZone)
+		opts.Params += encodeParams(o.Params)
+		setIf(&opts.CheckErr, o.CheckErr)
+		setIf(&opts.StmtTimeout, o.StmtTimeout)
+		setIf(&opts.TestTimeout, o.TestTimeout)
+		setIf(&opts.Retries, o.Retries)
+		setIf(&opts.AllMismatches, o.AllMismatches)
+		setIf(&opts.DiffContext, o.DiffContext)
+		opts.InitSQL = append(opts.InitSQL, sf.InitSQL...)
+	}
+	for _, name := range given.Flags {
+		if reapply, ok := suiteFlags[name]; ok {
+			reapply(opts, &given)
+		}
+	}
+}
+
+// suiteFlags set the options of the suite files from the flag of their
+// name, to the value it gave.
+var suiteFlags = map[string]func(opts, given *Options){
+	// the last of the parameters of a DSN wins
+	"params": func(opts, given *Options) {
+		opts.Params = strings.TrimPrefix(opts.Params, given.Params) + given.Params
+	},
+	"check-error":    func(opts, given *Options) { opts.CheckErr = given.CheckErr },
+	"stmt-timeout":   func(opts, given *Options) { opts.StmtTimeout = given.StmtTimeout },
+	"test-timeout":   func(opts, given *Options) { opts.TestTimeout = given.TestTimeout },
+	"retries":        func(opts, given *Options) { opts.Retries = given.Retries },
+	"all-mismatches": func(opts, given *Options) { opts.AllMismatches = given.AllMismatches },
+	"diff-context":   func(opts, given *Options) { opts.DiffContext = given.DiffContext },
+}
+
+// replaceRegex returns the replace rules applied to every statement.
+func (sc *suiteConfig) replaceRegex() []*ReplaceRegex {
+	var ret []*ReplaceRegex
+	for _, sf := range sc.files {
+		ret = append(ret, sf.replaceRegex...)
+	}
+	return ret
+}
+
+// setupFiles returns the setup files to run before the test, the ones of
+// the outer suites first.
+func (sc *suiteConfig) setupFiles() []string {
+	var files []string
+	for _, sf := range sc.files {
+		for _, f := range sf.Setup {
+			files = append(files, filepath.Join(sf.dir, f))
+		}
+	}
+	return files
+}
+
+// teardownFiles returns the teardown files to run after the test, the ones
+// of the inner suites first.
+func (sc *suiteConfig) teardownFiles() []string {
+	var files []string
+	for i := len(sc.files) - 1; i >= 0; i-- {
+		sf := sc.files[i]
+		for _, f := range sf.Teardown {
+			files = append(files, filepath.Join(sf.dir, f))
+		}
+	}
+	return files
+}
+
+// applySuite sets up the tester with the configuration of its suite.
+func (t *Tester) applySuite() error {
+	sc, err := t.r.suiteOf(t.name)
+	if err != nil {
+		return errors.Annotate(err, "load suite")
+	}
+	t.setSuite(sc)
+	return nil
+}
+
+func (t *Tester) setSuite(sc *suiteConfig) {
+	opts := *t.opts
+	sc.apply(&opts)
+	t.opts = &opts
+	t.suiteCfg = sc
+	t.suiteReplaceRegex = sc.replaceRegex()
+	t.connManager = newConnManager(t.opts)
+}
+
+// runScript runs the statements of a setup or teardown file on conn. Their
+// output is not recorded.
+func (t *Tester) runScript(file string, conn *Conn) error {
+	return runScript(file, func(stmt string) error {
+		return t.execScriptStmt(conn, stmt)
+	})
+}
+
+// runScript runs the statements of file with exec.
+func runScript(file string, exec func(stmt string) error) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	queries, err := ParseQueries(data)
+	if err != nil {
+		return errors.Annotatef(err, "parse %s", file)
+	}
+	for _, q := range queries {
+		if q.tp != Q_QUERY {
+			return errors.Errorf("%s:%d: only statements are supported, got --%s", file, q.Line, q.firstWord)
+		}
+		if err = exec(strings.TrimSuffix(q.Query, q.delimiter)); err != nil {
+			return errors.Annotatef(err, "%s:%d", file, q.Line)
+		}
+	}
+	return nil
+}
+
+func (t *Tester) execScriptStmt(conn *Conn, stmt string) error {
+	ctx, cancel := t.stmtContext()
+	defer cancel()
+	_, err := conn.conn.ExecContext(ctx, stmt)
+	return t.checkStmtTimeout(ctx, conn, err)
+}
+
+// setup copies the fixtures of the suite of the test into its schema, then
+// runs its setup files.
+func (t *Tester) setup() error {
+	for _, sf := range t.suiteCfg.files {
+		if sf.Fixture == nil {
+			continue
+		}
+		state, err := sf.Fixture.prepare(t, sf)
+		if err != nil {
+			return errors.Annotatef(err, "suite fixture of %s", filepath.Join(sf.dir, suiteFileName))
+		}
+		if err = sf.Fixture.copyInto(t, state); err != nil {
+			return errors.Annotatef(err, "copy suite fixture %s", state.schema)
+		}
+	}
+	for _, file := range t.suiteCfg.setupFiles() {
+		log.Debugf("%s: setup %s", t.name, file)
+		if err := t.runScript(file, t.curr); err != nil {
+			return errors.Annotate(err, "suite setup")
+		}
+	}
+	return nil
+}
+
+// teardown runs the teardown files of the suite of the test on its default
+// connection, or the current one if the test closed it. Their errors are
+// only logged, the outcome of the test is known by then.
+func (t *Tester) teardown() {
+	conn := t.conn[default_connection]
+	if conn == nil || conn.conn == nil {
+		conn = t.curr
+	}
+	if conn == nil || conn.conn == nil {
+		return
+	}
+	for _, file := range t.suiteCfg.teardownFiles() {
+		log.Debugf("%s: teardown %s", t.name, file)
+		if err := t.runScript(file, conn); err != nil {
+			log.Warnf("%s: suite teardown err %v", t.name, err)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuiteConfig(t *testing.T) {
+	r := testRunner()
+	r.opts.Dir = t.TempDir()
+	r.opts.InitSQL = []string{"SET @target = 1"}
+	write := func(name, content string) {
+		p := filepath.Join(r.opts.Dir, "t", name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+	write("suite.yaml", `
+options:
+  check_error: true
+  test_timeout: 1m
+init_sql: [SET @root = 1]
+setup: [root_setup.sql]
+teardown: [root_teardown.sql]
+`)
+	write("quickbi/suite.yaml", `
+options:
+  time_zone: UTC
+  test_timeout: 5m
+  params:
+    sql_mode: ""
+init_sql: [SET @quickbi = 1]
+replace_regex: ['/[0-9]{4}-[0-9]{2}-[0-9]{2}/<DATE>/']
+setup: [setup.sql]
+teardown: [teardown.sql]
+`)
+
+	sc, err := r.suiteOf("quickbi/sub/interval")
+	require.NoError(t, err)
+	require.Len(t, sc.files, 2)
+	opts := r.opts
+	sc.apply(&opts)
+	require.True(t, opts.CheckErr)
+	require.Equal(t, 5*time.Minute, opts.TestTimeout)
+	require.Equal(t, "UTC", opts.TimeZone)
+	require.Equal(t, "&sql_mode=", opts.Params)
+	require.Equal(t, []string{"SET @target = 1", "SET @root = 1", "SET @quickbi = 1"}, opts.InitSQL)
+	require.Equal(t, []string{"SET @target = 1"}, r.opts.InitSQL)
+	tDir := filepath.Join(r.opts.Dir, "t")
+	require.Equal(t, []string{filepath.Join(tDir, "root_setup.sql"), filepath.Join(tDir, "quickbi/setup.sql")}, sc.setupFiles())
+	require.Equal(t, []string{filepath.Join(tDir, "quickbi/teardown.sql"), filepath.Join(tDir, "root_teardown.sql")}, sc.teardownFiles())
+
+	tr := r.NewTester("quickbi/interval")
+	require.NoError(t, tr.applySuite())
+	require.Equal(t, "UTC", tr.opts.TimeZone)
+	require.Equal(t, "Asia/Shanghai", r.opts.TimeZone)
+	require.Equal(t, []string{"SET @target = 1", "SET @root = 1", "SET @quickbi = 1"}, tr.connManager.initSQL)
+	require.Len(t, tr.replaceRules(), 2)
+	tr.writeError(errors.New("bad date 2025-01-02"))
+	require.Equal(t, "bad date <DATE>\n", tr.buf.String())
+
+	// the flags given on the command line beat the suite files
+	opts = r.opts
+	opts.TestTimeout = time.Minute
+	opts.Params = "&sql_mode=ANSI"
+	opts.Flags = []string{"test-timeout", "params", "run"}
+	sc.apply(&opts)
+	require.Equal(t, time.Minute, opts.TestTimeout)
+	require.Equal(t, "&sql_mode=&sql_mode=ANSI", opts.Params)
+	require.Equal(t, "UTC", opts.TimeZone)
+	require.True(t, opts.CheckErr)
+
+	sc, err = r.suiteOf("example")
+	require.NoError(t, err)
+	require.Len(t, sc.files, 1)
+
+	write("broken/suite.yaml", "replace_regex: ['/(/x/']")
+	_, err = r.suiteOf("broken/a")
+	require.Error(t, err)
+}
+
+func TestSuiteFixture(t *testing.T) {
+	r := testRunner()
+	r.opts.Dir = t.TempDir()
+	p := filepath.Join(r.opts.Dir, "t", "quickbi", "suite.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+	require.NoError(t, os.WriteFile(p, []byte(`
+fixture:
+  setup: [inter2.sql]
+  teardown: [cleanup.sql]
+  shared: true
+`), 0644))
+
+	sc, err := r.suiteOf("quickbi/interval")
+	require.NoError(t, err)
+	require.Len(t, sc.files, 1)
+	f := sc.files[0].Fixture
+	require.NotNil(t, f)
+	require.Equal(t, []string{"inter2.sql"}, f.Setup)
+	require.Equal(t, []string{"cleanup.sql"}, f.Teardown)
+	require.True(t, f.Shared)
+	require.Equal(t, "quickbi", sc.files[0].suite)
+
+	require.Equal(t, "mysql_tester_fixture", fixtureSchemaName(""))
+	require.Equal(t, "mysql_tester_fixture__quickbi__sub", fixtureSchemaName("quickbi/sub"))
+
+	// nothing to tear down before a test set the fixture up
+	r.Cleanup()
+	require.Empty(t, f.states)
+}
@@ -0,0 +1,1174 @@
+// Copyright 2020 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/defined2014/mysql"
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	default_connection = "default"
+)
+
+type Conn struct {
+	// DB might be a shared one by multiple Conn, if the connection information are the same.
+	mdb *sql.DB
+	// connection information.
+	hostName string
+	userName string
+	password string
+	db       string
+
+	conn *sql.Conn
+	// connID is the CONNECTION_ID() of conn, used to kill timed out queries.
+	connID uint64
+}
+
+type ReplaceColumn struct {
+	col     int
+	replace []byte
+}
+
+type ReplaceRegex struct {
+	regex   *regexp.Regexp
+	replace string
+}
+
+// Tester runs a single test.
+type Tester struct {
+	r    *Runner
+	opts *Options
+
+	mdb  *sql.DB
+	name string
+
+	// dbName is the schema created for the test, see testSchemaName.
+	// schemaRule shows it as its schemaName in the output.
+	dbName     string
+	schemaRule *ReplaceRegex
+
+	originalSchemas map[string]struct{}
+
+	// 连接管理器，负责管理所有数据库连接
+	connManager *ConnectionManager
+
+	// 保留curr字段以便兼容旧代码
+	curr *Conn
+
+	buf bytes.Buffer
+
+	// enable query log will output origin statement into result file too
+	// use --disable_query_log or --enable_query_log to control it
+	enableQueryLog bool
+
+	// enable result log will output to result file or not.
+	// use --enable_result_log or --disable_result_log to control it
+	enableResultLog bool
+
+	// sortedResult make the output or the current query sorted.
+	sortedResult bool
+
+	enableConcurrent bool
+
+	// Disable or enable warnings. This setting is enabled by default.
+	// With this setting enabled, mysqltest uses SHOW WARNINGS to display
+	// any warnings produced by SQL statements.
+	enableWarning bool
+
+	// enable query info, like rowsAffected, lastMessage etc.
+	enableInfo bool
+
+	// check expected error, use --error before the statement
+	// see http://dev.mysql.com/doc/mysqltest/2.0/en/writing-tests-expecting-errors.html
+	// Besides codes and names, an SQLSTATE (S42S02) and a /message pattern/
+	// are accepted, see expectedError.
+	expectedErrs []expectedError
+
+	// only for test, not record, every time we execute a statement, we should check its
+	// output against the result file.
+	checker *ResultChecker
+
+	// capture keeps the output of each statement in stmts instead of
+	// checking it against the result file, see runDifferential.
+	capture bool
+	stmts   []capturedStmt
+
+	// conns record connection created by test.
+	conn map[string]*Conn
+
+	// currConnName record current connection name.
+	currConnName string
+
+	// replace output column through --replace_column 1 <static data> 3 #
+	replaceColumn []ReplaceColumn
+
+	// replace output result through --replace_regex /\.dll/.so/
+	replaceRegex []*ReplaceRegex
+
+	// suiteCfg is the configuration of the suite of the test, see suiteFile.
+	suiteCfg *suiteConfig
+	// suiteReplaceRegex are the replace rules of the suite, applied to
+	// every statement after its own --replace_regex.
+	suiteReplaceRegex []*ReplaceRegex
+
+	// queryCount is the number of statements executed so far.
+	queryCount int
+
+	// flaky holds the errors of the failed attempts when the test passed
+	// after being retried.
+	flaky []error
+
+	// meta is the metadata declared in the header of the test file.
+	meta *testMeta
+
+	// ctx is cancelled once the test ran longer than timeout.
+	ctx     context.Context
+	timeout time.Duration
+
+	// vars holds the variables set by --let. They are kept per test instead
+	// of in the environment, since tests may run in parallel.
+	vars map[string]string
+}
+
+// NewTester returns the tester of the test name.
+func (r *Runner) NewTester(name string) *Tester {
+	t := new(Tester)
+
+	t.r = r
+	t.opts = &r.opts
+	t.name = name
+	t.enableQueryLog = true
+	t.enableResultLog = true
+	// disable warning by default since our a lot of test cases
+	// are ported wihtout explictly "disablewarning"
+	t.enableWarning = false
+	t.enableConcurrent = false
+	t.enableInfo = false
+	t.dbName = r.testSchemaName(name)
+	t.schemaRule = &ReplaceRegex{regex: regexp.MustCompile(regexp.QuoteMeta(t.dbName)), replace: schemaName(name)}
+	t.vars = make(map[string]string)
+	t.ctx = context.Background()
+	// 初始化连接映射
+	t.conn = make(map[string]*Conn)
+	// 初始化连接管理器
+	t.connManager = newConnManager(t.opts)
+	t.suiteCfg = &suiteConfig{}
+
+	return t
+}
+
+// newConnManager returns a connection manager as told by opts.
+func newConnManager(opts *Options) *ConnectionManager {
+	cm := NewConnectionManager(opts.Port, opts.Params, opts.RetryConnCount)
+	cm.defaultTimeZone = opts.TimeZone
+	cm.allowAllFiles = opts.AllowAllFiles
+	cm.initSQL = opts.initSQL()
+	return cm
+}
+
+// replaceRules returns the replace rules of the current statement. The
+// schema of the test is shown without the suffix of the run first.
+func (t *Tester) replaceRules() []*ReplaceRegex {
+	rules := make([]*ReplaceRegex, 0, 1+len(t.replaceRegex)+len(t.suiteReplaceRegex))
+	rules = append(rules, t.schemaRule)
+	rules = append(rules, t.replaceRegex...)
+	return append(rules, t.suiteReplaceRegex...)
+}
+
+func (t *Tester) addConnection(connName, hostName, userName, password, db string) error {
+	// 使用连接管理器添加连接
+	conn, err := t.connManager.AddConnection(connName, hostName, userName, password, db, len(t.expectedErrs) > 0)
+	if err != nil {
+		if t.expectedErrs == nil {
+			return errors.Annotatef(err, "Open db for connection %v", connName)
+		}
+		t.expectedErrs = nil
+		return nil
+	}
+
+	// 为了兼容旧代码，仍然更新t.conn和t.curr
+	t.conn[connName] = conn
+	t.curr = conn
+	t.currConnName = connName
+	return nil
+}
+
+func (t *Tester) switchConnection(connName string) error {
+	// 使用连接管理器切换连接
+	conn, err := t.connManager.SwitchConnection(connName)
+	if err != nil {
+		return errors.Annotatef(err, "Connection %v doesn't exist", connName)
+	}
+
+	// 为了兼容旧代码，仍然更新t.mdb和t.curr
+	t.mdb = conn.mdb
+	t.curr = conn
+	t.currConnName = connName
+
+	// 同时更新旧的连接映射，保持一致性
+	t.conn[connName] = conn
+	return nil
+}
+
+func (t *Tester) disconnect(connName string) error {
+	// 使用连接管理器断开连接
+	err := t.connManager.DisconnectConnection(connName)
+	if err != nil {
+		return errors.Annotatef(err, "断开连接 %v 失败", connName)
+	}
+
+	// 从旧的连接映射中删除
+	delete(t.conn, connName)
+
+	// 如果存在默认连接，则切换到默认连接
+	if _, ok := t.conn[default_connection]; ok {
+		// 切换到默认连接
+		return t.switchConnection(default_connection)
+	}
+	// 如果没有默认连接，则清空当前连接
+	t.curr = nil
+	t.mdb = nil
+	t.currConnName = ""
+	return nil
+}
+
+// preProcess connects to the server and creates the schema of the test.
+// Its errors are infrastructure errors, see infraError.
+func (t *Tester) preProcess() error {
+	t.r.startTest(t.opts.server())
+	// 初始化连接映射
+	t.conn = make(map[string]*Conn)
+
+	// 使用test数据库建立初始连接
+	dbName := "test"
+	conn, err := t.connManager.AddConnection(default_connection, t.opts.Host, t.opts.User, t.opts.Password, dbName, false)
+	if err != nil {
+		return newInfraError(errors.Annotate(err, "Open db"))
+	}
+
+	// 获取数据库连接用于执行后续操作
+	mdb := conn.mdb
+
+	if !t.opts.ReserveSchema {
+		// 存储原始数据库架构
+		t.originalSchemas = make(map[string]struct{})
+		rows, err := mdb.Query("show databases")
+		if err != nil {
+			return newInfraError(errors.Annotate(err, "failed to get databases"))
+		}
+		for rows.Next() {
+			rows.Scan(&dbName)
+			t.originalSchemas[dbName] = struct{}{}
+		}
+	}
+
+	// 创建测试专用数据库
+	dbName = t.dbName
+	if err = acquireSchema(t.opts.server(), dbName); err != nil {
+		return newInfraError(err)
+	}
+	log.Debugf("Create new db `%s`", dbName)
+	if _, err = mdb.Exec(fmt.Sprintf("create database `%s`", dbName)); err != nil {
+		return newInfraError(errors.Annotatef(err, "Executing create db %s", dbName))
+	}
+
+	// 断开旧连接
+	t.connManager.DisconnectConnection(default_connection)
+	delete(t.conn, default_connection)
+
+	// 创建新连接到测试数据库
+	conn, err = t.connManager.AddConnection(default_connection, t.opts.Host, t.opts.User, t.opts.Password, dbName, false)
+	if err != nil {
+		return newInfraError(errors.Annotate(err, "Open db"))
+	}
+
+	// 更新tester状态
+	t.conn[default_connection] = conn
+	t.curr = conn
+	t.mdb = conn.mdb
+	t.currConnName = default_connection
+
+	// the dialect is known since the run started, unless the test runs
+	// on its own with RunTest
+	if _, err = t.r.detectDialect(t.ctx, conn, t.opts); err != nil {
+		return newInfraError(errors.Annotate(err, "detect server dialect"))
+	}
+	return nil
+}
+
+func (t *Tester) postProcess() {
+	// 使用延迟函数确保所有连接在函数结束时关闭
+	defer func() {
+		releaseSchema(t.opts.server(), t.dbName)
+		t.r.endTest(t.opts.server())
+
+		// 使用连接管理器关闭所有连接
+		t.connManager.CloseAllConnections()
+
+		// 为了兼容旧代码，也关闭t.conn中的连接
+		for _, v := range t.conn {
+			if v.conn != nil {
+				v.conn.Close()
+			}
+		}
+
+		// 如果t.mdb存在，也关闭它
+		if t.mdb != nil {
+			t.mdb.Close()
+		}
+	}()
+
+	// 如果不保留数据库架构，则删除测试过程中创建的数据库
+	if !t.opts.ReserveSchema {
+		// 确保有活动连接
+		if t.curr == nil || t.curr.mdb == nil {
+			log.Error("无法清理数据库：当前连接为空")
+			return
+		}
+
+		// 查询所有数据库
+		rows, err := t.curr.mdb.Query("show databases")
+		if err != nil {
+			log.Errorf("failed to get databases: %s", err.Error())
+			return
+		}
+
+		// 删除测试过程中创建的数据库
+		var dbName string
+		for rows.Next() {
+			rows.Scan(&dbName)
+			// Schemas of other running tests are theirs to drop.
+			if dbName != t.dbName && isActiveSchema(t.opts.server(), dbName) {
+				continue
+			}
+			if _, exists := t.originalSchemas[dbName]; !exists {
+				if dbName != t.dbName && t.r.concurrentTests(t.opts.server()) {
+					// another running test may have created it
+					t.r.leaveSchema(t.opts, dbName)
+					continue
+				}
+				_, err := t.curr.mdb.Exec(fmt.Sprintf("drop database `%s`", dbName))
+				if err != nil {
+					log.Errorf("failed to drop database: %s", err.Error())
+					return
+				}
+			}
+		}
+	}
+}
+
+func (t *Tester) addFailure(startTime *time.Time, err *error, cnt int) {
+	t.r.suiteLock.Lock()
+	defer t.r.suiteLock.Unlock()
+	testCase := XUnitTestCase{
+		Classname:  "",
+		Name:       reportTestName(t.name),
+		Time:       fmt.Sprintf("%fs", time.Since(*startTime).Seconds()),
+		QueryCount: cnt,
+	}
+	if isInfraError(*err) {
+		testCase.Error = (*err).Error()
+		t.r.suite.Errors++
+	} else {
+		testCase.Failure = (*err).Error()
+		t.r.suite.Failures++
+	}
+	t.r.suite.TestCases = append(t.r.suite.TestCases, testCase)
+}
+
+func (t *Tester) addSuccess(startTime *time.Time, cnt int) {
+	t.r.suiteLock.Lock()
+	defer t.r.suiteLock.Unlock()
+	testCase := XUnitTestCase{
+		Classname:  "",
+		Name:       reportTestName(t.name),
+		Time:       fmt.Sprintf("%fs", time.Since(*startTime).Seconds()),
+		QueryCount: cnt,
+	}
+	for _, err := range t.flaky {
+		testCase.FlakyFailures = append(testCase.FlakyFailures, XUnitFlakyFailure{Message: err.Error()})
+	}
+	if len(t.flaky) > 0 {
+		t.r.suite.Flaky++
+	}
+	t.r.suite.TestCases = append(t.r.suite.TestCases, testCase)
+}
+
+// Run runs the test and records its outcome in the XUnit report. A failed
+// run is retried up to Retries times, each time with a fresh tester and
+// schema. A test passing after a retry keeps the errors of the failed
+// attempts in t.flaky.
+func (t *Tester) Run() error {
+	startTime := time.Now()
+	err := t.applySuite()
+	if err == nil {
+		err = t.attempt()
+	}
+	for attempt := 1; err != nil && !isSkipError(err) && attempt <= t.opts.Retries && !t.r.Interrupted(); attempt++ {
+		log.Warnf("%s: attempt %d failed, retrying: %v", t.name, attempt, err)
+		t.flaky = append(t.flaky, err)
+		rt := t.r.NewTester(t.name)
+		rt.meta = t.meta
+		rt.setSuite(t.suiteCfg)
+		err = rt.attempt()
+		t.queryCount = rt.queryCount
+	}
+	if reason, ok := skipReason(err); ok {
+		t.flaky = nil
+		t.r.recordSkippedTest(testTask{test: t.name, skip: reason})
+		return err
+	}
+	if err != nil {
+		t.flaky = nil
+		t.addFailure(&startTime, &err, t.queryCount)
+	} else if t.opts.XUnitFile != "" {
+		t.addSuccess(&startTime, t.queryCount)
+	}
+	return err
+}
+
+func (t *Tester) run() error {
+	cancel := t.startTest()
+	defer cancel()
+	defer t.postProcess()
+	if err := t.preProcess(); err != nil {
+		return err
+	}
+	defer t.teardown()
+	if err := t.setup(); err != nil {
+		return err
+	}
+	queries, err := t.loadQueries()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	if err = t.openResult(); err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err := t.writeDiffFile(); err != nil {
+			log.Warnf("%s: write diff file err %v", t.name, err)
+		}
+		if err := t.writeRejectFile(); err != nil {
+			log.Warnf("%s: write reject file err %v", t.name, err)
+		}
+	}()
+
+	var s string
+	startTime := time.Now()
+	var concurrentQueue []Query
+	var concurrentSize int
+	for _, q := range queries {
+		if err = t.checkInterrupted(); err != nil {
+			return errors.Annotate(err, fmt.Sprintf("before line %d", q.Line))
+		}
+		if err = t.testTimedOut(); err != nil {
+			err = errors.Annotate(err, fmt.Sprintf("before line %d", q.Line))
+			return err
+		}
+		s = q.Query
+		switch q.tp {
+		case Q_ENABLE_QUERY_LOG:
+			t.enableQueryLog = true
+		case Q_DISABLE_QUERY_LOG:
+			t.enableQueryLog = false
+		case Q_ENABLE_RESULT_LOG:
+			t.enableResultLog = true
+		case Q_DISABLE_RESULT_LOG:
+			t.enableResultLog = false
+		case Q_DISABLE_WARNINGS:
+			t.enableWarning = false
+		case Q_ENABLE_WARNINGS:
+			t.enableWarning = true
+		case Q_ENABLE_INFO:
+			t.enableInfo = true
+		case Q_DISABLE_INFO:
+			t.enableInfo = false
+		case Q_BEGIN_CONCURRENT:
+			// mysql-tester enhancement
+			concurrentQueue = make([]Query, 0)
+			t.enableConcurrent = true
+			if s == "" {
+				concurrentSize = 8
+			} else {
+				concurrentSize, err = strconv.Atoi(strings.TrimSpace(s))
+				if err != nil {
+					err = errors.Annotate(err, "Atoi failed")
+					return err
+				}
+			}
+		case Q_END_CONCURRENT:
+			t.enableConcurrent = false
+			if err = t.concurrentRun(concurrentQueue, concurrentSize); err != nil {
+				err = errors.Annotate(err, fmt.Sprintf("concurrent test failed in %v", t.name))
+				return err
+			}
+			t.expectedErrs = nil
+		case Q_ERROR:
+			t.expectedErrs, err = parseExpectedErrors(strings.TrimSpace(s))
+			if err != nil {
+				err = errors.Annotate(err, fmt.Sprintf("Could not parse --error: line: %d", q.Line))
+				return err
+			}
+		case Q_ECHO:
+			varSearch := regexp.MustCompile(`\$([A-Za-z0-9_]+)( |$)`)
+			s := varSearch.ReplaceAllStringFunc(s, func(s string) string {
+				return t.getVar(varSearch.FindStringSubmatch(s)[1])
+			})
+
+			t.buf.WriteString(s)
+			t.buf.WriteString("\n")
+		case Q_QUERY:
+			if t.enableConcurrent {
+				concurrentQueue = append(concurrentQueue, q)
+			} else if err = t.execute(q); err != nil {
+				err = errors.Annotate(err, fmt.Sprintf("sql:%v", q.Query))
+				return err
+			}
+
+			t.queryCount++
+
+			t.sortedResult = false
+			t.replaceColumn = nil
+			t.replaceRegex = nil
+		case Q_SORTED_RESULT:
+			t.sortedResult = true
+		case Q_REPLACE_COLUMN:
+			// TODO: Use CSV module or so to handle quoted replacements
+			t.replaceColumn = nil // Only use the latest one!
+			cols := strings.Fields(q.Query)
+			// Require that col + replacement comes in pairs otherwise skip the last column number
+			for i := 0; i < len(cols)-1; i = i + 2 {
+				colNr, err := strconv.Atoi(cols[i])
+				if err != nil {
+					err = errors.Annotate(err, fmt.Sprintf("Could not parse column in --replace_column: sql:%v", q.Query))
+					return err
+				}
+
+				t.replaceColumn = append(t.replaceColumn, ReplaceColumn{col: colNr, replace: []byte(cols[i+1])})
+			}
+		case Q_CONNECT:
+			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
+			q.Query = q.Query[1 : len(q.Query)-1]
+			args := strings.Split(q.Query, ",")
+			for i := range args {
+				args[i] = strings.TrimSpace(args[i])
+			}
+			for i := 0; i < 4; i++ {
+				args = append(args, "")
+			}
+			if err = t.addConnection(args[0], args[1], args[2], args[3], args[4]); err != nil {
+				return errors.Annotate(err, fmt.Sprintf("--connect at line %d", q.Line))
+			}
+		case Q_CONNECTION:
+			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
+			if err = t.switchConnection(q.Query); err != nil {
+				return errors.Annotate(err, fmt.Sprintf("--connection at line %d", q.Line))
+			}
+		case Q_DISCONNECT:
+			q.Query = strings.TrimSuffix(strings.TrimSpace(q.Query), q.delimiter)
+			if err = t.disconnect(q.Query); err != nil {
+				return errors.Annotate(err, fmt.Sprintf("--disconnect at line %d", q.Line))
+			}
+		case Q_LET:
+			if err = t.let(q); err != nil {
+				return err
+			}
+		case Q_REMOVE_FILE:
+			err = os.Remove(strings.TrimSpace(q.Query))
+			if err != nil {
+				return errors.Annotate(err, "failed to remove file")
+			}
+		case Q_REQUIRE_DIALECT, Q_REQUIRE_VERSION, Q_REQUIRE_CAPABILITY:
+			if err = t.checkRequire(q); err != nil {
+				return err
+			}
+		case Q_ENABLE_PS_PROTOCOL, Q_ENABLE_SESSION_TRACK_INFO, Q_RESET_CONNECTION:
+			d, err := t.r.detectDialect(t.ctx, t.curr, t.opts)
+			if err != nil {
+				return errors.Annotatef(err, "line %d", q.Line)
+			}
+			if err = t.requireCapability(d, commandCapabilities[q.tp]); err != nil {
+				return err
+			}
+			log.WithFields(log.Fields{"command": q.firstWord, "arguments": q.Query, "line": q.Line}).Warn("command not implemented")
+		case Q_REPLACE_REGEX:
+			t.replaceRegex = nil
+			regex, err := ParseReplaceRegex(q.Query)
+			if err != nil {
+				return errors.Annotate(err, fmt.Sprintf("Could not parse regex in --replace_regex: line: %d sql:%v", q.Line, q.Query))
+			}
+			t.replaceRegex = regex
+		default:
+			log.WithFields(log.Fields{"command": q.firstWord, "arguments": q.Query, "line": q.Line}).Warn("command not implemented")
+		}
+	}
+
+	// check do we have remained lines in result file, and report the mismatches
+	// collected with -all-mismatches
+	if t.checker != nil {
+		if err = t.checker.Finish(t.buf.Bytes()); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if err = t.flushResult(); err != nil {
+		return errors.Trace(err)
+	}
+	if !t.capture {
+		fmt.Printf("%s: ok! %d test cases passed, take time %v s\n", reportTestName(t.name), t.queryCount, time.Since(startTime).Seconds())
+	}
+	return nil
+}
+
+func (t *Tester) concurrentRun(concurrentQueue []Query, concurrentSize int) error {
+	if len(concurrentQueue) == 0 {
+		return nil
+	}
+	offset := t.buf.Len()
+
+	if concurrentSize <= 0 {
+		return errors.Errorf("concurrentSize must be positive")
+	}
+	if concurrentSize > len(concurrentQueue) {
+		concurrentSize = len(concurrentQueue)
+	}
+	batchQuery := make([][]Query, concurrentSize)
+	for i, query := range concurrentQueue {
+		j := i % concurrentSize
+		batchQuery[j] = append(batchQuery[j], query)
+	}
+	errOccured := make(chan error, len(concurrentQueue))
+	var wg sync.WaitGroup
+	wg.Add(len(batchQuery))
+	for _, q := range batchQuery {
+		go t.concurrentExecute(q, &wg, errOccured)
+	}
+	wg.Wait()
+	close(errOccured)
+	if err, ok := <-errOccured; ok {
+		return errors.Annotate(err, "Run failed")
+	}
+	buf := t.buf.Bytes()[:offset]
+	t.buf = *(bytes.NewBuffer(buf))
+	return nil
+}
+
+func (t *Tester) concurrentExecute(querys []Query, wg *sync.WaitGroup, errOccured chan error) {
+	defer wg.Done()
+	// 创建新的tester实例用于并发执行
+	tt := t.r.NewTester(t.name)
+	// the workers connect like the test, with the init SQL of its suite
+	tt.setSuite(t.suiteCfg)
+
+	// 使用连接管理器创建到测试数据库的连接
+	conn, err := tt.connManager.AddConnection(default_connection, t.opts.Host, t.opts.User, t.opts.Password, t.dbName, false)
+	if err != nil {
+		errOccured <- errors.Annotate(err, "Open db")
+		return
+	}
+
+	// 更新tester状态
+	tt.ctx, tt.timeout = t.ctx, t.timeout
+	tt.curr = conn
+	tt.mdb = conn.mdb
+	tt.conn[default_connection] = conn
+
+	// 确保所有连接在函数结束时关闭
+	defer tt.connManager.CloseAllConnections()
+
+	for _, query := range querys {
+		if len(query.Query) == 0 {
+			return
+		}
+
+		err := tt.stmtExecute(query)
+		if err != nil && len(t.expectedErrs) > 0 {
+			for _, e := range t.expectedErrs {
+				if e.match(err) {
+					err = nil
+					break
+				}
+			}
+		}
+		if err != nil {
+			errOccured <- errors.Trace(errors.Errorf("run \"%v\" at line %d err %v", query.Query, query.Line, err))
+			return
+		}
+	}
+}
+
+// getVar returns the value of a --let variable, falling back to the
+// environment.
+func (t *Tester) getVar(name string) string {
+	if v, ok := t.vars[name]; ok {
+		return v
+	}
+	return os.Getenv(name)
+}
+
+func (t *Tester) loadQueries() ([]Query, error) {
+	data, err := os.ReadFile(t.testFileName())
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueries(data)
+}
+
+func (t *Tester) stmtExecute(query Query) (err error) {
+	if t.enableQueryLog {
+		t.buf.WriteString(query.Query)
+		t.buf.WriteString("\n")
+	}
+
+	return t.executeStmt(strings.TrimSuffix(query.Query, query.delimiter))
+}
+
+// checkExpectedError check if error was expected
+// If so, it will handle Buf and return nil
+func (t *Tester) checkExpectedError(q Query, err error) error {
+	if err == nil {
+		if len(t.expectedErrs) == 0 || acceptsSuccess(t.expectedErrs) {
+			// 0 means accept any error!
+			return nil
+		}
+		if !t.opts.CheckErr {
+			log.Warnf("%s:%d query succeeded, but expected error(s)! (expected errors: %s) (query: %s)",
+				t.name, q.Line, expectedErrorsString(t.expectedErrs), q.Query)
+			return nil
+		}
+		return errors.Errorf("Statement succeeded, expected error(s) '%s'", expectedErrorsString(t.expectedErrs))
+	}
+	if len(t.expectedErrs) == 0 {
+		return err
+	}
+	for _, e := range t.expectedErrs {
+		if e.unknownName() {
+			if len(t.expectedErrs) > 1 {
+				log.Warnf("%s:%d Unknown named error %s in --error %s", t.name, q.Line, e.codeName, expectedErrorsString(t.expectedErrs))
+			} else {
+				log.Warnf("%s:%d Unknown named --error %s", t.name, q.Line, e.codeName)
+			}
+			continue
+		}
+		if e.match(err) {
+			if len(t.expectedErrs) == 1 || !t.opts.CheckErr {
+				// !t.opts.CheckErr - Also keep old behavior, i.e. not use "Got one of the listed errors"
+				t.writeError(err)
+			} else if !t.expectedErrs[0].success {
+				fmt.Fprintf(&t.buf, "Got one of the listed errors\n")
+			}
+			return nil
+		}
+	}
+	if _, ok := errors.Cause(err).(*mysql.MySQLError); !ok {
+		log.Warnf("%s:%d Could not parse mysql error: %s", t.name, q.Line, err.Error())
+		return err
+	}
+	if !t.opts.CheckErr {
+		log.Warnf("%s:%d query failed with non expected error(s)! (expected: %s) (got: %s) (query: %s)",
+			t.name, q.Line, expectedErrorsString(t.expectedErrs), describeError(err), q.Query)
+		t.writeError(err)
+		return nil
+	}
+	return errors.Errorf("query failed with non expected error(s)!\nexpected: %s\ngot: %s",
+		expectedErrorsString(t.expectedErrs), describeError(err))
+}
+
+// writeError writes err to the result buffer after applying --replace_regex.
+func (t *Tester) writeError(err error) {
+	errStr := err.Error()
+	for _, reg := range t.replaceRules() {
+		errStr = reg.regex.ReplaceAllString(errStr, reg.replace)
+	}
+	fmt.Fprintf(&t.buf, "%s\n", strings.ReplaceAll(errStr, "\r", ""))
+}
+
+func (t *Tester) execute(query Query) error {
+	if len(query.Query) == 0 {
+		return nil
+	}
+
+	offset := t.buf.Len()
+	stmtErr := t.stmtExecute(query)
+	if isTimeout(stmtErr) {
+		return errors.Trace(errors.Errorf("run \"%v\" at line %d err %v", query.Query, query.Line, stmtErr))
+	}
+
+	err := t.checkExpectedError(query, stmtErr)
+	captured := capturedStmt{line: query.Line, query: query.Query, start: offset}
+	if t.capture && err != nil {
+		if stmtErr != nil {
+			captured.err = stmtErr.Error()
+		}
+		err = t.captureError(stmtErr, err)
+	}
+	if err != nil {
+		return errors.Trace(errors.Errorf("run \"%v\" at line %d err %v", query.Query, query.Line, err))
+	}
+
+	// clear expected errors after we execute the first query
+	t.expectedErrs = nil
+
+	if t.capture {
+		captured.end = t.buf.Len()
+		t.stmts = append(t.stmts, captured)
+		return nil
+	}
+
+	if !t.opts.recording() {
+		// check test result now
+		echo := ""
+		if t.enableQueryLog {
+			echo = query.Query + "\n"
+		}
+		return errors.Trace(t.checker.Check(query, t.buf.Bytes(), offset, echo))
+	}
+
+	return errors.Trace(err)
+}
+
+func (t *Tester) writeQueryResult(rows *byteRows) error {
+	if t.sortedResult {
+		sort.Sort(rows)
+	}
+
+	if len(t.replaceColumn) > 0 {
+		for _, row := range rows.data {
+			for _, r := range t.replaceColumn {
+				if len(row.data) < r.col {
+					continue
+				}
+				row.data[r.col-1] = r.replace
+			}
+		}
+	}
+
+	cols := rows.cols
+	for i, c := range cols {
+		t.buf.WriteString(c)
+		if i != len(cols)-1 {
+			t.buf.WriteString("\t")
+		}
+	}
+	t.buf.WriteString("\n")
+
+	for _, row := range rows.data {
+		var value string
+		for i, col := range row.data {
+			// replace result by regex
+			for _, reg := range t.replaceRules() {
+				col = reg.regex.ReplaceAll(col, []byte(reg.replace))
+			}
+
+			// Here we can check if the value is nil (NULL value)
+			if col == nil {
+				value = "NULL"
+			} else {
+				value = string(col)
+			}
+			t.buf.WriteString(value)
+			if i < len(row.data)-1 {
+				t.buf.WriteString("\t")
+			}
+		}
+		t.buf.WriteString("\n")
+	}
+	return nil
+}
+
+type byteRow struct {
+	data [][]byte
+}
+
+type byteRows struct {
+	cols []string
+	data []byteRow
+}
+
+func (rows *byteRows) Len() int {
+	return len(rows.data)
+}
+
+func (rows *byteRows) Less(i, j int) bool {
+	r1 := rows.data[i]
+	r2 := rows.data[j]
+	for i := 0; i < len(r1.data); i++ {
+		res := bytes.Compare(r1.data[i], r2.data[i])
+		switch res {
+		case -1:
+			return true
+		case 1:
+			return false
+		case 0:
+			// bytes.Compare(nil, []byte{}) returns 0
+			// But in sql row representation, they are NULL and empty string "" respectively, and thus not equal.
+			// So we need special logic to handle here: make NULL < ""
+			if r1.data[i] == nil && r2.data[i] != nil {
+				return true
+			}
+			if r1.data[i] != nil && r2.data[i] == nil {
+				return false
+			}
+		}
+	}
+	return false
+}
+
+func (rows *byteRows) Swap(i, j int) {
+	rows.data[i], rows.data[j] = rows.data[j], rows.data[i]
+}
+
+func dumpToByteRows(rows *sql.Rows) (*byteRows, error) {
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	data := make([]byteRow, 0, 8)
+	args := make([]interface{}, len(cols))
+	for {
+		for rows.Next() {
+			tmp := make([][]byte, len(cols))
+			for i := 0; i < len(args); i++ {
+				args[i] = &tmp[i]
+			}
+			err := rows.Scan(args...)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+
+			data = append(data, byteRow{tmp})
+		}
+		if !rows.NextResultSet() {
+			break
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	return &byteRows{cols: cols, data: data}, nil
+}
+
+func (t *Tester) executeStmt(query string) error {
+	log.Debugf("executeStmt: %s", query)
+	ctx, cancel := t.stmtContext()
+	defer cancel()
+	raw, err := t.curr.conn.QueryContext(ctx, query)
+	if err != nil {
+		return errors.Trace(t.checkStmtTimeout(ctx, t.curr, err))
+	}
+
+	rows, err := dumpToByteRows(raw)
+	if err != nil {
+		return errors.Trace(t.checkStmtTimeout(ctx, t.curr, err))
+	}
+
+	if t.enableResultLog && (len(rows.cols) > 0 || len(rows.data) > 0) {
+		if err = t.writeQueryResult(rows); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	if t.enableInfo {
+		err = t.curr.conn.Raw(func(driverConn any) error {
+			rowsAffected := driverConn.(*mysql.MysqlConn).RowsAffected()
+			lastMessage := driverConn.(*mysql.MysqlConn).LastMessage()
+			t.buf.WriteString(fmt.Sprintf("affected rows: %d\n", rowsAffected))
+			t.buf.WriteString(fmt.Sprintf("info: %s\n", lastMessage))
+			return nil
+		})
+		if err != nil {
+			log.Errorf("failed to get info: %s", err.Error())
+		}
+	}
+
+	if t.enableWarning {
+		raw, err := t.curr.conn.QueryContext(ctx, "show warnings")
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		rows, err := dumpToByteRows(raw)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		if len(rows.data) > 0 {
+			sort.Sort(rows)
+			return t.writeQueryResult(rows)
+		}
+	}
+	return nil
+}
+
+// executeStmtString runs the query of a --let on the current connection,
+// which got the init SQL unlike the other connections of the pool.
+// let sets the variable of the --let command q. The backquoted queries of
+// its value are replaced by their result. A timed out query fails the test,
+// other errors only leave their part of the value empty.
+func (t *Tester) let(q Query) error {
+	q.Query = strings.TrimSpace(q.Query)
+	eqIdx := strings.Index(q.Query, "=")
+	if eqIdx <= 1 {
+		return nil
+	}
+	start := 0
+	if q.Query[0] == '$' {
+		start = 1
+	}
+	varName := strings.TrimSpace(q.Query[start:eqIdx])
+	varValue := strings.TrimSpace(q.Query[eqIdx+1:])
+	varSearch := regexp.MustCompile("`(.*)`")
+	var timeoutErr error
+	varValue = varSearch.ReplaceAllStringFunc(varValue, func(s string) string {
+		s = strings.Trim(s, "`")
+		r, err := t.executeStmtString(s)
+		if err != nil {
+			if isTimeout(err) && timeoutErr == nil {
+				timeoutErr = err
+			}
+			log.WithFields(log.Fields{
+				"query": s, "line": q.Line},
+			).Error("failed to perform let query")
+			return ""
+		}
+		return r
+	})
+	if timeoutErr != nil {
+		return errors.Annotatef(timeoutErr, "--let at line %d", q.Line)
+	}
+	t.vars[varName] = varValue
+	return nil
+}
+
+func (t *Tester) executeStmtString(query string) (string, error) {
+	var result string
+	ctx, cancel := t.stmtContext()
+	defer cancel()
+	err := t.curr.conn.QueryRowContext(ctx, query).Scan(&result)
+	if err != nil {
+		return "", t.checkStmtTimeout(ctx, t.curr, err)
+	}
+	return result, nil
+}
+
+func (t *Tester) openResult() error {
+	if t.opts.recording() || t.capture {
+		return nil
+	}
+
+	expected, err := os.ReadFile(t.resultFileName())
+	if err != nil {
+		return err
+	}
+	t.checker = NewResultChecker(expected, t.opts.AllMismatches, t.opts.DiffContext)
+	return nil
+}
+
+func (t *Tester) flushResult() error {
+	if !t.opts.recording() || t.capture {
+		return nil
+	}
+	path := t.resultFileName()
+	if t.opts.RecordMode != RecordDryRun {
+		// Create all directories in the file path
+		dir := filepath.Dir(path)
+		if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+			return fmt.Errorf("failed to create directories: %v", err)
+		}
+	}
+	return t.recordResult(path)
+}
+
+func (t *Tester) testFileName() string {
+	return t.opts.testFileName(t.name)
+}
+
+// reportTestName is the name of the test name in reports, its file relative
+// to the directory of the tests.
+func reportTestName(name string) string {
+	return fmt.Sprintf("./t/%s.test", name)
+}
+
+func hasCollationPrefix(name string) bool {
+	names := strings.Split(name, "/")
+	caseName := names[len(names)-1]
+	return strings.HasPrefix(caseName, "collation")
+}
+
+// schemaName returns the name of the schema of a test, which is unique as
+// long as test names are, see testSchemaName.
+func schemaName(testName string) string {
+	return strings.ReplaceAll(testName, "/", "__")
+}
+
+// testSchemaName returns the schema of the test name in the run of r: its
+// schemaName with the ID of the run, which the output of the test does not
+// show.
+func (r *Runner) testSchemaName(testName string) string {
+	return schemaName(testName) + "_" + r.runID
+}
+
+func (t *Tester) resultFileName() string {
+	return t.opts.resultFileName(t.name)
+}
+
+// LoadAllTests returns the name of every test under the t directory of
+// Dir, the collation tests only with CollationDisable.
+func (o *Options) LoadAllTests() ([]string, error) {
+	tests := make([]string, 0)
+	testDir := filepath.Join(o.Dir, "t")
+	// tests must be in t folder or subdir in t folder
+	err := filepath.Walk(testDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && strings.HasSuffix(path, ".test") {
+			rel, err := filepath.Rel(testDir, path)
+			if err != nil {
+				return err
+			}
+			name := filepath.ToSlash(strings.TrimSuffix(rel, ".test"))
+			if !o.CollationDisable || hasCollationPrefix(name) {
+				tests = append(tests, name)
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return tests, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pingcap/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// killTimeout bounds the side connection used to kill a timed out query.
+const killTimeout = 5 * time.Second
+
+// timeoutError is returned when a statement or the whole test exceeded its
+// deadline. It is never matched by --error.
+type timeoutError struct {
+	// scope is "statement" or "test".
+	scope   string
+	timeout time.Duration
+}
+
+func (e *timeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %v", e.scope, e.timeout)
+}
+
+func isTimeout(err error) bool {
+	_, ok := errors.Cause(err).(*timeoutError)
+	return ok
+}
+
+// testTimeoutOf returns the deadline of a test, its @timeout or the
+// TestTimeout default. 0 means none.
+func (o *Options) testTimeoutOf(meta *testMeta) time.Duration {
+	if meta != nil && meta.timeout > 0 {
+		return meta.timeout
+	}
+	return o.TestTimeout
+}
+
+// startTest sets up the context of the whole test, which is cancelled
+// once its deadline passed.
+func (t *Tester) startTest() context.CancelFunc {
+	t.timeout = t.opts.testTimeoutOf(t.meta)
+	if t.timeout <= 0 {
+		t.ctx = context.Background()
+		return func() {}
+	}
+	var cancel context.CancelFunc
+	t.ctx, cancel = context.WithTimeout(context.Background(), t.timeout)
+	return cancel
+}
+
+// testTimedOut returns a timeoutError once the deadline of the test passed.
+func (t *Tester) testTimedOut() error {
+	if t.ctx.Err() != nil {
+		return &timeoutError{scope: "test", timeout: t.timeout}
+	}
+	return nil
+}
+
+// stmtContext returns the context of a single statement, limited by both
+// StmtTimeout and the deadline of the test.
+func (t *Tester) stmtContext() (context.Context, context.CancelFunc) {
+	if t.opts.StmtTimeout <= 0 {
+		return context.WithCancel(t.ctx)
+	}
+	return context.WithTimeout(t.ctx, t.opts.StmtTimeout)
+}
+
+// runStmtContext returns the context of a statement run for the whole run
+// rather than for a test, e.g. the setup of a suite fixture, limited by the
+// StmtTimeout of opts only.
+func runStmtContext(opts *Options) (context.Context, context.CancelFunc) {
+	if opts.StmtTimeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), opts.StmtTimeout)
+}
+
+// checkStmtTimeout turns err into a timeoutError if ctx ran out, after
+// killing the statement on the server. Cancelling the context only closes
+// our side of the connection, the server would go on executing it.
+func (t *Tester) checkStmtTimeout(ctx context.Context, conn *Conn, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	t.killQuery(conn)
+	if te := t.testTimedOut(); te != nil {
+		return te
+	}
+	return &timeoutError{scope: "statement", timeout: t.opts.StmtTimeout}
+}
+
+// killQuery issues KILL QUERY for the session of conn on a side connection.
+func (t *Tester) killQuery(conn *Conn) {
+	if conn == nil || conn.connID == 0 {
+		log.Warnf("%s: can not kill the timed out Query, unknown connection id", t.name)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), killTimeout)
+	defer cancel()
+	side, err := conn.mdb.Conn(ctx)
+	if err != nil {
+		log.Warnf("%s: open connection to kill query %d err %v", t.name, conn.connID, err)
+		return
+	}
+	defer side.Close()
+	if _, err = side.ExecContext(ctx, fmt.Sprintf("KILL QUERY %d", conn.connID)); err != nil {
+		log.Warnf("%s: kill query %d err %v", t.name, conn.connID, err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tester
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestTimeout(t *testing.T) {
+	r := testRunner()
+	r.opts.TestTimeout = time.Minute
+	require.Equal(t, time.Minute, r.opts.testTimeoutOf(nil))
+	require.Equal(t, time.Minute, r.opts.testTimeoutOf(&testMeta{}))
+	require.Equal(t, 5*time.Minute, r.opts.testTimeoutOf(&testMeta{timeout: 5 * time.Minute}))
+
+	tr := r.NewTester("timeout")
+	tr.meta = &testMeta{timeout: time.Millisecond}
+	cancel := tr.startTest()
+	defer cancel()
+	<-tr.ctx.Done()
+	err := tr.testTimedOut()
+	require.True(t, isTimeout(err))
+	require.Equal(t, "test timed out after 1ms", err.Error())
+}
+
+func TestStmtTimeout(t *testing.T) {
+	r := testRunner()
+	r.opts.StmtTimeout = time.Millisecond
+
+	tr := r.NewTester("timeout")
+	cancel := tr.startTest()
+	defer cancel()
+	ctx, stmtCancel := tr.stmtContext()
+	defer stmtCancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	require.WithinDuration(t, time.Now(), deadline, time.Second)
+
+	other := errors.New("bad connection")
+	require.Equal(t, other, tr.checkStmtTimeout(context.Background(), nil, other))
+	<-ctx.Done()
+	err := tr.checkStmtTimeout(ctx, nil, other)
+	require.True(t, isTimeout(errors.Trace(err)))
+	require.Equal(t, "statement timed out after 1ms", err.Error())
+
+	r.opts.StmtTimeout = 0
+	ctx, stmtCancel = tr.stmtContext()
+	defer stmtCancel()
+	_, ok = ctx.Deadline()
+	require.False(t, ok)
+}
+
+func TestLetTimeout(t *testing.T) {
+	d := &recordingDriver{tables: [][]driver.Value{{"t1"}}}
+	db := sql.OpenDB(d)
+	defer db.Close()
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	r := testRunner()
+	tr := r.NewTester("let")
+	tr.curr = &Conn{mdb: db, conn: conn}
+
+	require.NoError(t, tr.let(Query{Query: "$t = `SHOW TABLES`", Line: 1}))
+	require.Equal(t, "t1", tr.getVar("t"))
+
+	// a timed out query fails the test instead of setting an empty value
+	r.opts.StmtTimeout = time.Nanosecond
+	err = tr.let(Query{Query: "$t = `SELECT SLEEP(1)`", Line: 2})
+	require.True(t, isTimeout(err))
+	require.ErrorContains(t, err, "--let at line 2")
+	require.Equal(t, "t1", tr.getVar("t"))
+}
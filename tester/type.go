@@ -11,7 +11,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package tester
 
 import (
 	"strings"
@@ -114,6 +114,9 @@ var commandMap = map[string]int{
 	"single_query":               Q_SINGLE_QUERY,
 	"begin_concurrent":           Q_BEGIN_CONCURRENT,
 	"end_concurrent":             Q_END_CONCURRENT,
+	"require_dialect":            Q_REQUIRE_DIALECT,
+	"require_version":            Q_REQUIRE_VERSION,
+	"require_capability":         Q_REQUIRE_CAPABILITY,
 }
 
 func findType(cmdName string) int {
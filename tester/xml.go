@@ -11,7 +11,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package tester
 
 import (
 	"encoding/xml"
@@ -25,8 +25,8 @@ import (
 
 // XUnitTestSuites is a set of mysqltest suite.
 type XUnitTestSuites struct {
-	XMLName xml.Name `xml:"testsuites"`
-	Suites  []XUnitTestSuite
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []XUnitTestSuite `xml:"testsuite"`
 }
 
 // XUnitTestSuite is a single mysqltest suite which may contain many
@@ -35,10 +35,13 @@ type XUnitTestSuite struct {
 	XMLName    xml.Name        `xml:"testsuite"`
 	Tests      int             `xml:"tests,attr"`
 	Failures   int             `xml:"failures,attr"`
+	Errors     int             `xml:"errors,attr"`
+	Skipped    int             `xml:"skipped,attr"`
+	Flaky      int             `xml:"flaky,attr"`
 	Name       string          `xml:"name,attr"`
 	Time       string          `xml:"time,attr"`
 	Properties []XUnitProperty `xml:"properties>property,omitempty"`
-	TestCases  []XUnitTestCase
+	TestCases  []XUnitTestCase `xml:"testcase"`
 }
 
 // XUnitTestCase is a single test case with its result.
@@ -49,6 +52,23 @@ type XUnitTestCase struct {
 	Time       string   `xml:"time,attr"`
 	QueryCount int      `xml:"query-count,attr"`
 	Failure    string   `xml:"failure,omitempty"`
+	Error      string   `xml:"error,omitempty"`
+	Skipped    *XUnitSkipped
+	// FlakyFailures are the failed attempts of a test which passed after
+	// being retried, as in the Maven Surefire format.
+	FlakyFailures []XUnitFlakyFailure `xml:"flakyFailure"`
+}
+
+// XUnitFlakyFailure is a failed attempt of a flaky test.
+type XUnitFlakyFailure struct {
+	XMLName xml.Name `xml:"flakyFailure"`
+	Message string   `xml:"message,attr"`
+}
+
+// XUnitSkipped marks a test case which did not run.
+type XUnitSkipped struct {
+	XMLName xml.Name `xml:"skipped"`
+	Message string   `xml:"message,attr"`
 }
 
 // XUnitProperty represents a key/value pair used to define properties.